@@ -0,0 +1,265 @@
+package injection
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Body can be embedded into request handler input value struct types, marking the type as bound from request body.
+// Values of bound types are decoded from request body by Routes implementing BindingRoutes interface, example:
+// type CreateUserRequest struct { injection.Body; Name string `json:"name"` }
+// handlers can inject bound type either as struct value or as pointer to struct value
+type Body struct{}
+
+// BindingRoutes is optional Routes capability for http libraries decoding request body into request handler input values
+type BindingRoutes interface {
+	// Bind decodes request body into value pointed by given target from values seeded into request resolution
+	Bind(seeds []reflect.Value, target interface{}) error
+}
+
+var bindables = struct {
+	sync.RWMutex
+	types map[reflect.Type]bool
+}{types: map[reflect.Type]bool{}}
+
+// RegisterBindable marks type T as bound from request body, same as embedding Body into the type,
+// used for types which can not embed Body, for example types declared in other packages.
+// Handlers can inject registered type either as T or as *T
+func RegisterBindable[T any]() {
+	bindables.Lock()
+	defer bindables.Unlock()
+
+	bindableType := reflect.TypeOf(new(T)).Elem()
+
+	if bindableType.Kind() == reflect.Ptr {
+		bindableType = bindableType.Elem()
+	}
+
+	bindables.types[bindableType] = true
+}
+
+// isBindable reports whether values of given type are bound from request body
+func isBindable(valueType reflect.Type) bool {
+	if valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	bindables.RLock()
+	registered := bindables.types[valueType]
+	bindables.RUnlock()
+
+	if registered {
+		return true
+	}
+
+	if valueType.Kind() != reflect.Struct {
+		return false
+	}
+
+	bodyType := reflect.TypeOf(Body{})
+
+	for i := 0; i < valueType.NumField(); i++ {
+		if field := valueType.Field(i); field.Anonymous && field.Type == bodyType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// binder sets value pointed by given target from request, seeds are values seeded into request resolution
+// and dependencies are resolved values of bound value provider dependency types
+type binder func(target reflect.Value, seeds []reflect.Value, dependencies []reflect.Value)
+
+// boundProvider returns value provider of given type setting value from request with given binder,
+// provider depends on values seeded into request resolution followed by given dependency types.
+// Bound value is validated with Injector Validator, request handling is aborted when value is invalid.
+// Panics when validate tag rules of the type checked by default Validator are invalid
+func (r *Injector) boundProvider(valueType reflect.Type, dependencyTypes []reflect.Type, bind binder) *providerDefinition {
+	targetType := valueType
+
+	if valueType.Kind() == reflect.Ptr {
+		targetType = valueType.Elem()
+	}
+
+	// validate tag rules checked by default Validator are compiled when request handler is registered
+	if _, ok := r.root().validator.(ruleValidator); ok && targetType.Kind() == reflect.Struct {
+		if _, err := compileTagRules(targetType); err != nil {
+			panic(err)
+		}
+	}
+
+	seedCount := len(r.seedTypes)
+	paramTypes := append(append([]reflect.Type{}, r.seedTypes...), dependencyTypes...)
+	fnType := reflect.FuncOf(paramTypes, []reflect.Type{valueType}, false)
+	fn := reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		target := reflect.New(targetType)
+
+		bind(target, args[:seedCount], args[seedCount:])
+		r.validate(target)
+
+		if valueType.Kind() == reflect.Ptr {
+			return []reflect.Value{target}
+		}
+
+		return []reflect.Value{target.Elem()}
+	})
+
+	provider := newProviderDefinition(valueType, fn, bindingLifetime)
+	provider.synthesized = true
+
+	return provider
+}
+
+// dereferencedProvider returns value provider of bound type dereferencing value bound into pointer to the type,
+// request is bound once for request handlers of the request injecting both T and *T
+func dereferencedProvider(valueType reflect.Type) *providerDefinition {
+	fnType := reflect.FuncOf([]reflect.Type{reflect.PtrTo(valueType)}, []reflect.Type{valueType}, false)
+	fn := reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{args[0].Elem()}
+	})
+
+	provider := newProviderDefinition(valueType, fn, bindingLifetime)
+	provider.synthesized = true
+
+	return provider
+}
+
+// bodyBinder returns binder decoding request body with Routes BindingRoutes implementation,
+// request handling is aborted with 400 status code error when request body cannot be decoded.
+// Panics when Injector Routes do not implement BindingRoutes interface
+func (r *Injector) bodyBinder(valueType reflect.Type) binder {
+	bindingRoutes, ok := r.routes.(BindingRoutes)
+
+	if !ok {
+		panic(newUnsupportedBindingRoutesError(r.routes, valueType))
+	}
+
+	return func(target reflect.Value, seeds []reflect.Value, _ []reflect.Value) {
+		if err := bindingRoutes.Bind(seeds, target.Interface()); err != nil {
+			panic(requestFailure{NewRequestError(http.StatusBadRequest, err)})
+		}
+	}
+}
+
+// ErrNotFound should be returned by route binding resolvers when no value exists for route parameter value,
+// request handling is aborted with 404 status code
+var ErrNotFound = errors.New("not found")
+
+// BindRoute registers route binding resolver providing values of type T from route path parameter with given name,
+// example: injection.BindRoute[*User](injector, "user", func(ctx context.Context, id string) (*User, error) {...})
+// handler registered for "/users/:user" route receives *User value resolved by the resolver.
+// Resolver last input parameter receives route path parameter value, preceding input parameters are injected.
+// Request handling is aborted with 404 status code when path parameter is missing, resolver returns error
+// matching ErrNotFound or returns nil value, other resolver errors are passed to Injector error handler.
+// returns error when:
+// - resolver is not a function with last string input parameter, returning T and error values
+// - resolver input parameters contain unregistered values
+// - Injector Routes do not implement ParamRoutes interface
+// Registering request handler injecting T fails when its route has no path parameter with given name
+func BindRoute[T any](injector *Injector, paramName string, resolver interface{}) (err error) {
+	defer func() {
+		e := recover()
+
+		if injectErr, ok := e.(Error); ok {
+			err = injectErr
+			return
+		}
+
+		if e != nil {
+			panic(e)
+		}
+	}()
+
+	valueType := reflect.TypeOf(new(T)).Elem()
+	injector.addProvider(injector.routeBindingProvider(valueType, paramName, funcValueOf(resolver)))
+
+	return nil
+}
+
+// routeBindingProvider returns value provider calling route binding resolver with route path parameter value,
+// provider depends on values seeded into request resolution and resolver injected input values
+func (r *Injector) routeBindingProvider(
+	valueType reflect.Type,
+	paramName string,
+	resolver reflect.Value,
+) *providerDefinition {
+	resolverType := resolver.Type()
+	errorType := reflect.TypeOf(new(error)).Elem()
+
+	if resolverType.NumIn() == 0 || resolverType.In(resolverType.NumIn()-1).Kind() != reflect.String ||
+		resolverType.NumOut() != 2 || resolverType.Out(0) != valueType || resolverType.Out(1) != errorType {
+		panic(newInvalidRouteBindingError(valueType, resolverType))
+	}
+
+	paramRoutes, ok := r.routes.(ParamRoutes)
+
+	if !ok {
+		panic(newUnsupportedParamRoutesError(r.routes, valueType))
+	}
+
+	dependencyTypes := fnParamTypes(resolverType, 0)[:resolverType.NumIn()-1]
+	paramType := resolverType.In(resolverType.NumIn() - 1)
+
+	for _, dependencyType := range dependencyTypes {
+		if _, exists := r.provider(dependencyType); !exists {
+			panic(newUnknownProviderRequestError(dependencyType))
+		}
+	}
+
+	seedCount := len(r.seedTypes)
+	paramTypes := append(append([]reflect.Type{}, r.seedTypes...), dependencyTypes...)
+	fnType := reflect.FuncOf(paramTypes, []reflect.Type{valueType}, false)
+	fn := reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		values := paramRoutes.Param(args[:seedCount], PathParam, paramName)
+
+		if len(values) == 0 {
+			panic(requestFailure{NewRequestError(http.StatusNotFound, ErrNotFound)})
+		}
+
+		resolverArgs := append(append([]reflect.Value{}, args[seedCount:]...), reflect.ValueOf(values[0]).Convert(paramType))
+		results := resolver.Call(resolverArgs)
+
+		if err, _ := results[1].Interface().(error); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				err = NewRequestError(http.StatusNotFound, err)
+			}
+
+			panic(requestFailure{err})
+		}
+
+		if isNilValue(results[0]) {
+			panic(requestFailure{NewRequestError(http.StatusNotFound, ErrNotFound)})
+		}
+
+		return results[:1]
+	})
+
+	provider := newProviderDefinition(valueType, fn, bindingLifetime)
+	provider.source = resolver
+	provider.paramName = paramName
+
+	return provider
+}
+
+// checkRouteParam panics when route injecting value of given route binding provider has no path parameter
+// the value is resolved from. Values injected into middleware registered with Use method are resolved for any route
+// and are not checked
+func (r *Injector) checkRouteParam(route string, provider *providerDefinition) {
+	_, endPoint, isRoute := strings.Cut(route, " ")
+
+	if !isRoute {
+		return
+	}
+
+	for _, segment := range strings.Split(r.root().routePrefix+endPoint, "/") {
+		if segmentParamName(segment) == provider.paramName {
+			return
+		}
+	}
+
+	panic(newMissingRouteParamError(provider.kind, provider.paramName, route))
+}
@@ -0,0 +1,93 @@
+// Command injection-check statically verifies injection wiring of Go packages.
+// It finds value providers registered with Injector RegisterProviders method and reports every request handler
+// parameter, controller field and provider dependency which no registered provider can satisfy,
+// errors which otherwise surface only at application startup.
+//
+// Usage:
+//
+//	injection-check [-seed type] [packages]
+//
+// Types seeded by known adapters (github.com/surmus/injection/gin, github.com/surmus/injection/nethttp)
+// are detected from package imports,
+// additional seeded types can be given with -seed flag in fully qualified form, example: -seed "*example.com/web.Context".
+//
+// Providers registered by any of given packages satisfy values of all given packages. When providers can not be
+// inspected statically, for example provider slice spread into RegisterProviders: injector.RegisterProviders(providers...),
+// unsatisfied values are reported as unknown and do not fail the check
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/surmus/injection/internal/inspect"
+	"os"
+	"strings"
+)
+
+type seedsFlag []string
+
+func (f *seedsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *seedsFlag) Set(value string) error {
+	*f = append(*f, value)
+
+	return nil
+}
+
+func main() {
+	var seeds seedsFlag
+
+	flag.Var(&seeds, "seed", "fully qualified type seeded into request resolution by Routes implementation, can be repeated")
+	flag.Parse()
+
+	patterns := flag.Args()
+
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	os.Exit(run(patterns, seeds))
+}
+
+func run(patterns []string, seeds []string) int {
+	pkgs, err := inspect.Load(".", patterns...)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	exitCode := 0
+	var wirings []*inspect.Wiring
+
+	for _, pkg := range pkgs {
+		for _, pkgErr := range pkg.Errors {
+			fmt.Fprintln(os.Stderr, pkgErr)
+			exitCode = 2
+		}
+
+		if len(pkg.Errors) > 0 {
+			continue
+		}
+
+		wirings = append(wirings, inspect.Collect(pkg, seeds...))
+	}
+
+	// providers registered by any loaded package satisfy values of all loaded packages
+	for _, issue := range inspect.Check(wirings...) {
+		if issue.Unknown {
+			fmt.Println(issue.String() + " (unknown)")
+			continue
+		}
+
+		fmt.Println(issue)
+
+		if exitCode == 0 {
+			exitCode = 1
+		}
+	}
+
+	return exitCode
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/surmus/injection/internal/inspect"
+	"go/ast"
+	"go/types"
+	"golang.org/x/tools/imports"
+	"path"
+	"sort"
+	"strings"
+)
+
+const injectionPath = "github.com/surmus/injection"
+
+const seedVar = "c"
+
+type generator struct {
+	wiring    *inspect.Wiring
+	seed      types.Type
+	providers map[string]*inspect.Provider
+	imports   map[string]string
+	body      bytes.Buffer
+	skipped   []string
+}
+
+// resolution composes statements resolving values for single request handler call,
+// singletons lists Wrapper Fn params receiving functions returning singleton provider values from Injector,
+// resolving holds types which providers are being resolved, detecting cyclic provider dependencies
+type resolution struct {
+	g          *generator
+	stmts      []string
+	vars       map[string]string
+	resolving  map[string]bool
+	providers  []string
+	singletons []string
+	varCount   int
+}
+
+// generate composes source of Wrapper registrations for every request handler function and Controller
+// request handler method in wiring whose values can be resolved without reflection,
+// returns source and descriptions of skipped request handlers
+func generate(wiring *inspect.Wiring) ([]byte, []string, error) {
+	g := &generator{
+		wiring:    wiring,
+		providers: make(map[string]*inspect.Provider),
+		imports:   make(map[string]string),
+	}
+
+	for _, seed := range wiring.Seeds {
+		if g.seed = wiring.LookupType(seed); g.seed != nil {
+			break
+		}
+	}
+
+	if g.seed == nil {
+		return nil, nil, fmt.Errorf("package %s does not use injection adapter with known request handler type", wiring.Package.PkgPath)
+	}
+
+	for _, provider := range wiring.Providers {
+		g.providers[inspect.TypeString(provider.Result)] = provider
+	}
+
+	g.qualifier(types.NewPackage(injectionPath, "injection"))
+	g.generateHandlers()
+	g.generateControllers()
+
+	return g.source()
+}
+
+func (g *generator) generateHandlers() {
+	generated := make(map[*types.Func]bool)
+	handlers := append([]*inspect.Handler{}, g.wiring.Handlers...)
+
+	for _, controller := range g.wiring.Controllers {
+		for _, action := range controller.Actions {
+			handlers = append(handlers, action.Middleware...)
+		}
+	}
+
+	for _, handler := range handlers {
+		handlerFn := g.namedFunc(handler.Expr)
+
+		if handlerFn == nil {
+			g.skip(handler.Pos.String(), "handler is not package level function")
+			continue
+		}
+
+		if generated[handlerFn] {
+			continue
+		}
+
+		if handlerFn.Type().(*types.Signature).Results().Len() > 0 {
+			g.skip(handlerFn.FullName(), "handler return values are rendered or stored into request scope by injector")
+			continue
+		}
+
+		generated[handlerFn] = true
+		resolution := g.newResolution()
+		args, err := resolution.resolveAll(handler.Params)
+
+		if err != nil {
+			g.skip(handlerFn.FullName(), err.Error())
+			continue
+		}
+
+		fmt.Fprintf(&g.body, "\tinjection.RegisterWrapper(injection.Wrapper{\n")
+		fmt.Fprintf(&g.body, "\t\tHandler: %s,\n", g.funcName(handlerFn))
+		fmt.Fprintf(&g.body, "\t\tProviders: []injection.Provider{%s},\n", strings.Join(resolution.providers, ", "))
+		fmt.Fprintf(&g.body, "\t\tFn: func(%s) %s {\n", strings.Join(resolution.singletons, ", "), g.handlerType())
+		fmt.Fprintf(&g.body, "\t\t\treturn func(%s %s) {\n", seedVar, g.typeString(g.seed))
+		fmt.Fprintf(&g.body, "%s", resolution.source("\t\t\t\t"))
+		fmt.Fprintf(&g.body, "\t\t\t\t%s(%s)\n", g.funcName(handlerFn), strings.Join(args, ", "))
+		fmt.Fprintf(&g.body, "\t\t\t}\n\t\t},\n\t})\n")
+	}
+}
+
+func (g *generator) generateControllers() {
+	generated := make(map[*types.Func]bool)
+
+	for _, controller := range g.wiring.Controllers {
+		if controller.Type.Obj().Pkg() != g.wiring.Package.Types {
+			g.skip(inspect.TypeString(controller.Type), "controller is declared in another package")
+			continue
+		}
+
+		if controller.Fields == nil {
+			g.skip(inspect.TypeString(controller.Type), "controller value is not known statically")
+			continue
+		}
+
+		for _, action := range controller.Actions {
+			if action.Method == nil || generated[action.Method] {
+				continue
+			}
+
+			generated[action.Method] = true
+
+			if action.Method.Type().(*types.Signature).Results().Len() > 0 {
+				g.skip(action.Method.FullName(), "handler return values are rendered by injector")
+				continue
+			}
+
+			g.generateAction(controller, action)
+		}
+	}
+}
+
+func (g *generator) generateAction(controller *inspect.Controller, action *inspect.Action) {
+	var fieldNames, fieldArgs []string
+
+	resolution := g.newResolution()
+	args, err := resolution.resolveAll(paramTypes(action.Method))
+
+	for _, field := range controller.Fields {
+		if err != nil {
+			break
+		}
+
+		var fieldArg string
+
+		fieldArg, err = resolution.resolve(field.Type())
+		fieldNames = append(fieldNames, fmt.Sprintf("%q", field.Name()))
+		fieldArgs = append(fieldArgs, fmt.Sprintf("resolvedCtrl.%s = %s", field.Name(), fieldArg))
+	}
+
+	if err != nil {
+		g.skip(action.Method.FullName(), err.Error())
+		return
+	}
+
+	ctrlType := g.typeString(controller.Type)
+	methodExpr := fmt.Sprintf("%s.%s", ctrlType, action.MethodName)
+	ctrlCopy := "ctrl"
+
+	if controller.Pointer {
+		ctrlType = "*" + ctrlType
+		methodExpr = fmt.Sprintf("(%s).%s", ctrlType, action.MethodName)
+		ctrlCopy = "*ctrl"
+	}
+
+	fnParams := append([]string{"ctrl " + ctrlType}, resolution.singletons...)
+
+	fmt.Fprintf(&g.body, "\tinjection.RegisterWrapper(injection.Wrapper{\n")
+	fmt.Fprintf(&g.body, "\t\tHandler: %s,\n", methodExpr)
+	fmt.Fprintf(&g.body, "\t\tProviders: []injection.Provider{%s},\n", strings.Join(resolution.providers, ", "))
+	fmt.Fprintf(&g.body, "\t\tFields: []string{%s},\n", strings.Join(fieldNames, ", "))
+	fmt.Fprintf(&g.body, "\t\tFn: func(%s) %s {\n", strings.Join(fnParams, ", "), g.handlerType())
+	fmt.Fprintf(&g.body, "\t\t\treturn func(%s %s) {\n", seedVar, g.typeString(g.seed))
+	fmt.Fprintf(&g.body, "%s", resolution.source("\t\t\t\t"))
+	fmt.Fprintf(&g.body, "\t\t\t\tresolvedCtrl := %s\n", ctrlCopy)
+
+	for _, fieldArg := range fieldArgs {
+		fmt.Fprintf(&g.body, "\t\t\t\t%s\n", fieldArg)
+	}
+
+	fmt.Fprintf(&g.body, "\t\t\t\tresolvedCtrl.%s(%s)\n", action.MethodName, strings.Join(args, ", "))
+	fmt.Fprintf(&g.body, "\t\t\t}\n\t\t},\n\t})\n")
+}
+
+func (g *generator) source() ([]byte, []string, error) {
+	var src bytes.Buffer
+
+	fmt.Fprintf(&src, "// Code generated by injection-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", g.wiring.Package.Name)
+
+	importPaths := make([]string, 0, len(g.imports))
+
+	for importPath := range g.imports {
+		importPaths = append(importPaths, importPath)
+	}
+
+	sort.Strings(importPaths)
+
+	fmt.Fprintf(&src, "import (\n")
+
+	for _, importPath := range importPaths {
+		if g.imports[importPath] == path.Base(importPath) {
+			fmt.Fprintf(&src, "\t%q\n", importPath)
+		} else {
+			fmt.Fprintf(&src, "\t%s %q\n", g.imports[importPath], importPath)
+		}
+	}
+
+	fmt.Fprintf(&src, ")\n\n")
+
+	fmt.Fprintf(&src, "func init() {\n%s}\n", g.body.String())
+
+	// imports registered by skipped request handlers are removed when formatting
+	formatted, err := imports.Process("", src.Bytes(), nil)
+
+	return formatted, g.skipped, err
+}
+
+func (g *generator) newResolution() *resolution {
+	return &resolution{g: g, vars: make(map[string]string), resolving: make(map[string]bool)}
+}
+
+// handlerType returns Routes HandlerFnType signature of generated request handlers
+func (g *generator) handlerType() string {
+	return fmt.Sprintf("func(%s)", g.typeString(g.seed))
+}
+
+func (g *generator) skip(subject string, reason string) {
+	g.skipped = append(g.skipped, fmt.Sprintf("%s: %s", subject, reason))
+}
+
+// namedFunc returns package level function referenced by expression, nil for function literals and method values
+func (g *generator) namedFunc(expr ast.Expr) *types.Func {
+	var ident *ast.Ident
+
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		ident = expr
+	case *ast.SelectorExpr:
+		ident = expr.Sel
+	default:
+		return nil
+	}
+
+	fn, ok := g.wiring.Package.TypesInfo.Uses[ident].(*types.Func)
+
+	if !ok || fn.Type().(*types.Signature).Recv() != nil {
+		return nil
+	}
+
+	if fn.Pkg() != g.wiring.Package.Types && !fn.Exported() {
+		return nil
+	}
+
+	return fn
+}
+
+func (g *generator) funcName(fn *types.Func) string {
+	if qualifier := g.qualifier(fn.Pkg()); qualifier != "" {
+		return qualifier + "." + fn.Name()
+	}
+
+	return fn.Name()
+}
+
+func (g *generator) typeString(valueType types.Type) string {
+	return types.TypeString(valueType, g.qualifier)
+}
+
+// qualifier returns name generated source refers to given package with, registering package import
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == nil || pkg.Path() == g.wiring.Package.PkgPath {
+		return ""
+	}
+
+	if name, imported := g.imports[pkg.Path()]; imported {
+		return name
+	}
+
+	name := pkg.Name()
+
+	for i := 2; g.importNameUsed(name); i++ {
+		name = fmt.Sprintf("%s%d", pkg.Name(), i)
+	}
+
+	g.imports[pkg.Path()] = name
+
+	return name
+}
+
+func (g *generator) importNameUsed(name string) bool {
+	for _, importName := range g.imports {
+		if importName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *resolution) resolveAll(valueTypes []types.Type) ([]string, error) {
+	var args []string
+
+	for _, valueType := range valueTypes {
+		arg, err := r.resolve(valueType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
+
+// resolve adds statements resolving value of given type, returns expression holding resolved value
+func (r *resolution) resolve(valueType types.Type) (string, error) {
+	typeString := inspect.TypeString(valueType)
+
+	if typeString == inspect.TypeString(r.g.seed) {
+		return seedVar, nil
+	}
+
+	if resolvedVar, resolved := r.vars[typeString]; resolved {
+		return resolvedVar, nil
+	}
+
+	provider, exists := r.g.providers[typeString]
+
+	if !exists {
+		return "", fmt.Errorf("no registered provider for %s", typeString)
+	}
+
+	providerFn := r.g.namedFunc(provider.Expr)
+
+	if providerFn == nil {
+		return "", fmt.Errorf("provider of %s is not package level function", typeString)
+	}
+
+	if r.resolving[typeString] {
+		return "", fmt.Errorf("cyclic dependency of %s provider", typeString)
+	}
+
+	r.resolving[typeString] = true
+	providerCall, err := r.providerCall(provider, providerFn)
+	delete(r.resolving, typeString)
+
+	if err != nil {
+		return "", err
+	}
+
+	resolvedVar := fmt.Sprintf("v%d", r.varCount)
+	r.varCount++
+	r.vars[typeString] = resolvedVar
+
+	r.stmts = append(r.stmts, fmt.Sprintf("%s := %s", resolvedVar, providerCall))
+
+	return resolvedVar, nil
+}
+
+// providerCall returns expression calling provider, singleton provider value is resolved by Injector
+// holding the singleton and passed to Wrapper Fn as function returning it
+func (r *resolution) providerCall(provider *inspect.Provider, providerFn *types.Func) (string, error) {
+	if provider.Singleton {
+		singletonVar := fmt.Sprintf("singleton%d", len(r.singletons))
+		r.singletons = append(r.singletons, fmt.Sprintf(
+			"%s func(%s) %s",
+			singletonVar,
+			r.g.typeString(r.g.seed),
+			r.g.typeString(provider.Result),
+		))
+		r.addProvider(fmt.Sprintf("injection.NewSingletonProvider(%s)", r.g.funcName(providerFn)))
+
+		return fmt.Sprintf("%s(%s)", singletonVar, seedVar), nil
+	}
+
+	args, err := r.resolveAll(provider.Params)
+	r.addProvider(r.g.funcName(providerFn))
+
+	return fmt.Sprintf("%s(%s)", r.g.funcName(providerFn), strings.Join(args, ", ")), err
+}
+
+func (r *resolution) addProvider(provider string) {
+	for _, added := range r.providers {
+		if added == provider {
+			return
+		}
+	}
+
+	r.providers = append(r.providers, provider)
+}
+
+func (r *resolution) source(indent string) string {
+	var src strings.Builder
+
+	for _, stmt := range r.stmts {
+		src.WriteString(indent + stmt + "\n")
+	}
+
+	return src.String()
+}
+
+func paramTypes(fn *types.Func) []types.Type {
+	var params []types.Type
+	signature := fn.Type().(*types.Signature)
+
+	for i := 0; i < signature.Params().Len(); i++ {
+		params = append(params, signature.Params().At(i).Type())
+	}
+
+	return params
+}
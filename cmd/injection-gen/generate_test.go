@@ -0,0 +1,79 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/surmus/injection/internal/inspect"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	pkgs, err := inspect.Load("testdata/app", ".")
+
+	assert.Nil(t, err)
+	assert.Len(t, pkgs, 1)
+
+	src, skipped, err := generate(inspect.Collect(pkgs[0]))
+
+	assert.Nil(t, err)
+	assert.Len(t, skipped, 1)
+	assert.Contains(t, skipped[0], "handler is not package level function")
+
+	assert.Equal(t, `// Code generated by injection-gen. DO NOT EDIT.
+
+package app
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/surmus/injection"
+)
+
+func init() {
+	injection.RegisterWrapper(injection.Wrapper{
+		Handler:   status,
+		Providers: []injection.Provider{injection.NewSingletonProvider(provideConfig), provideRepository},
+		Fn: func(singleton0 func(*gin.Context) *Config) func(*gin.Context) {
+			return func(c *gin.Context) {
+				v0 := singleton0(c)
+				v1 := provideRepository(c, v0)
+				status(c, v1)
+			}
+		},
+	})
+	injection.RegisterWrapper(injection.Wrapper{
+		Handler:   (*UserController).GetUsers,
+		Providers: []injection.Provider{injection.NewSingletonProvider(provideConfig), provideRepository},
+		Fields:    []string{"Repository"},
+		Fn: func(ctrl *UserController, singleton0 func(*gin.Context) *Config) func(*gin.Context) {
+			return func(c *gin.Context) {
+				v0 := singleton0(c)
+				v1 := provideRepository(c, v0)
+				resolvedCtrl := *ctrl
+				resolvedCtrl.Repository = v1
+				resolvedCtrl.GetUsers(c, v0)
+			}
+		},
+	})
+}
+`, string(src))
+}
+
+func TestGenerate_CyclicProviders(t *testing.T) {
+	pkgs, err := inspect.Load("testdata/cyclic", ".")
+
+	assert.Nil(t, err)
+	assert.Len(t, pkgs, 1)
+
+	src, skipped, err := generate(inspect.Collect(pkgs[0]))
+
+	assert.Nil(t, err)
+	assert.Len(t, skipped, 1)
+	assert.Contains(t, skipped[0], "cyclic.users: cyclic dependency of")
+	assert.Contains(t, string(src), "Handler:   status,")
+	assert.NotContains(t, string(src), "Handler:   users,")
+}
+
+func TestRun_NoPackage(t *testing.T) {
+	err := run(t.TempDir(), "injection_wrappers.go", "")
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,67 @@
+// Command injection-gen generates reflection free request handler wrappers for injection wiring of a package.
+// It finds value providers registered with Injector RegisterProviders method, request handler functions registered with
+// Handle and Use methods and controllers registered with RegisterController method, and writes plain Go functions
+// resolving request handler values by calling value providers directly. Generated wrappers are registered with
+// injection.RegisterWrapper function and used by Injector in place of reflection based request handlers.
+//
+// Only package level provider and handler functions can be wrapped, handlers using function literals
+// or providers not known statically keep using reflection. Singleton provider values resolved by generated wrappers
+// are shared between generated wrappers of the package.
+//
+// Usage, in package using injection:
+//
+//	//go:generate go run github.com/surmus/injection/cmd/injection-gen
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/surmus/injection/internal/inspect"
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+	output := flag.String("output", "injection_wrappers.go", "output file name")
+	seed := flag.String("seed", "", "fully qualified request handler context type, when not provided by known adapter")
+	flag.Parse()
+
+	if err := run(".", *output, *seed); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, output string, seed string) error {
+	var seeds []string
+
+	if seed != "" {
+		seeds = append(seeds, seed)
+	}
+
+	pkgs, err := inspect.Load(dir, ".")
+
+	if err != nil {
+		return err
+	}
+
+	if len(pkgs) != 1 {
+		return fmt.Errorf("cannot load package in %s: found %d packages", dir, len(pkgs))
+	}
+
+	if len(pkgs[0].Errors) > 0 {
+		return fmt.Errorf("cannot load package in %s: %v", dir, pkgs[0].Errors)
+	}
+
+	src, skipped, err := generate(inspect.Collect(pkgs[0], seeds...))
+
+	if err != nil {
+		return err
+	}
+
+	for _, skippedHandler := range skipped {
+		fmt.Fprintf(os.Stderr, "skipped %s\n", skippedHandler)
+	}
+
+	return ioutil.WriteFile(output, src, 0644)
+}
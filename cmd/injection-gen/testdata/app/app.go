@@ -0,0 +1,60 @@
+package app
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/surmus/injection"
+	injectiongin "github.com/surmus/injection/gin"
+	"net/http"
+)
+
+type Config struct {
+	Name string
+}
+
+type Repository struct {
+	Ctx    *gin.Context
+	Config *Config
+}
+
+type UserController struct {
+	injection.BaseController
+
+	Repository *Repository
+
+	name string
+}
+
+func NewUserController() *UserController {
+	return &UserController{name: "users"}
+}
+
+func (c *UserController) Routes() map[string][]string {
+	return map[string][]string{"/users": {"GetUsers"}}
+}
+
+func (c *UserController) GetUsers(ctx *gin.Context, config *Config) {
+	ctx.String(http.StatusOK, "%s %s", c.name, config.Name)
+}
+
+func provideConfig() *Config {
+	return &Config{Name: "app"}
+}
+
+func provideRepository(ctx *gin.Context, config *Config) *Repository {
+	return &Repository{Ctx: ctx, Config: config}
+}
+
+func status(ctx *gin.Context, repository *Repository) {
+	ctx.String(http.StatusOK, repository.Config.Name)
+}
+
+func Setup(router gin.IRoutes) *injection.Injector {
+	injector := injectiongin.Adapt(router)
+
+	injector.RegisterProviders(injection.NewSingletonProvider(provideConfig), provideRepository)
+	injector.Handle(http.MethodGet, "/status", status)
+	injector.Handle(http.MethodGet, "/closure", func(ctx *gin.Context) {})
+	injector.RegisterController(NewUserController())
+
+	return injector
+}
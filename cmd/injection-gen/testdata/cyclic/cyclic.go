@@ -0,0 +1,42 @@
+package cyclic
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/surmus/injection"
+	injectiongin "github.com/surmus/injection/gin"
+	"net/http"
+)
+
+type Users struct {
+	Groups *Groups
+}
+
+type Groups struct {
+	Users *Users
+}
+
+func provideUsers(groups *Groups) *Users {
+	return &Users{Groups: groups}
+}
+
+func provideGroups(users *Users) *Groups {
+	return &Groups{Users: users}
+}
+
+func status(ctx *gin.Context) {
+	ctx.Status(http.StatusOK)
+}
+
+func users(ctx *gin.Context, users *Users) {
+	ctx.Status(http.StatusOK)
+}
+
+func Setup(router gin.IRoutes) *injection.Injector {
+	injector := injectiongin.Adapt(router)
+
+	injector.RegisterProviders(provideUsers, provideGroups)
+	injector.Handle(http.MethodGet, "/status", status)
+	injector.Handle(http.MethodGet, "/users", users)
+
+	return injector
+}
@@ -0,0 +1,82 @@
+package injection
+
+import (
+	"context"
+	"reflect"
+)
+
+// contextRoute describes resolution of values requested from request context in request errors
+const contextRoute = "context"
+
+// scopeContextKey is context.Context value key of request Scope
+type scopeContextKey struct{}
+
+// ContextWithScope returns copy of given context holding given request scope,
+// used by Routes implementations storing request scope on request context
+func ContextWithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext returns request scope stored on given request context by Injector Routes implementing
+// ScopeRoutes interface, returns nil when context holds no request scope
+func ScopeFromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(scopeContextKey{}).(*Scope)
+
+	return scope
+}
+
+// FromContext returns value of type T from request scope stored on given request context,
+// enabling code receiving only context.Context to reach values resolved for the request.
+// Value not resolved yet is resolved with registered value provider and stored into request scope.
+// Returns error when context holds no request scope, T has no registered value provider or value resolution fails
+func FromContext[T any](ctx context.Context) (T, error) {
+	var value T
+
+	valueType := reflect.TypeOf(&value).Elem()
+	scope := ScopeFromContext(ctx)
+
+	if scope == nil {
+		return value, newMissingContextScopeError(valueType)
+	}
+
+	resolved, err := scope.resolve(valueType)
+
+	if err != nil {
+		return value, err
+	}
+
+	// nil interface value resolved for interface type T is returned as zero T
+	value, _ = resolved.Interface().(T)
+
+	return value, nil
+}
+
+// contextStep compiles resolution step of value requested from request context, steps are compiled once
+// into context resolution plan of the Injector. Returns error when type has no registered value provider
+func (r *Injector) contextStep(valueType reflect.Type) (step *resolutionStep, size int, err error) {
+	// providers of the Injector and its parents may be registered lazily while compiling
+	r.root().contextMutex.Lock()
+	defer r.root().contextMutex.Unlock()
+
+	defer func() {
+		e := recover()
+
+		if injectErr, ok := e.(Error); ok {
+			err = injectErr
+			return
+		}
+
+		if e != nil {
+			panic(e)
+		}
+	}()
+
+	if r.contextPlan == nil {
+		r.contextPlan = r.compilePlan(nil, contextRoute)
+	}
+
+	step = r.compileStep(r.contextPlan, valueType, make(map[reflect.Type]bool))
+	r.contextPlan.updateSize()
+
+	return step, r.contextPlan.size, nil
+}
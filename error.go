@@ -16,6 +16,28 @@ func (e Error) Error() string {
 	return e.error
 }
 
+// RequestError represents request handling error with http response status code,
+// request handlers can return RequestError in order to respond with status code other than 500
+type RequestError struct {
+	Status int
+	Err    error
+}
+
+// NewRequestError creates request handling error responded with given http status code
+func NewRequestError(status int, err error) *RequestError {
+	return &RequestError{Status: status, Err: err}
+}
+
+// Error returns wrapped error string representation
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns wrapped error
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
 func newProviderInvalidReturnCountError(providerType reflect.Type) Error {
 	return Error{fmt.Sprintf("cannot register value(%s) with multiple or no return values", providerType)}
 }
@@ -24,6 +46,27 @@ func newUnknownProviderRequestError(providerType reflect.Type) Error {
 	return Error{fmt.Sprintf("cannot inject value for unregistered type %s", providerType)}
 }
 
+func newCyclicDependencyError(providerType reflect.Type) Error {
+	return Error{fmt.Sprintf("cannot resolve value for type %s with cyclic provider dependencies", providerType)}
+}
+
+func newNonSingletonControllerFieldError(ctrlType reflect.Type, fieldType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot register singleton controller %s with field %s not provided by singleton provider",
+		ctrlType,
+		fieldType,
+	)}
+}
+
+func newSingletonControllerActionHookError(ctrlType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot register singleton controller %s with %s or %s method, singleton controller instance is shared by requests",
+		ctrlType,
+		beforeActionMethod,
+		afterActionMethod,
+	)}
+}
+
 func newUnknownHTTPHandlerMethodName(ctrlType reflect.Type, missingMethod string) Error {
 	return Error{fmt.Sprintf(
 		"cannot register unknown request handler method %s for controller %s",
@@ -32,6 +75,150 @@ func newUnknownHTTPHandlerMethodName(ctrlType reflect.Type, missingMethod string
 	)}
 }
 
+func newInvalidActionHookError(ctrlType reflect.Type, methodName string) Error {
+	return Error{fmt.Sprintf(
+		"cannot register controller %s, %s method has unsupported return values",
+		ctrlType,
+		methodName,
+	)}
+}
+
+func newInvalidHandlerResultsError(fnType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot register request handler %s, supported return values are T, error, (T, error) and (int, T)",
+		fnType,
+	)}
+}
+
+func newUnsupportedResponseRoutesError(routes Routes, fnType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot render return values of request handler %s, routes %T do not implement ResponseRoutes",
+		fnType,
+		routes,
+	)}
+}
+
+func newInvalidMiddlewareResultsError(fnType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot register middleware %s, supported return values are T, error, bool and (T, error)",
+		fnType,
+	)}
+}
+
+func newUnsupportedAbortRoutesError(routes Routes, fnType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot register middleware %s aborting request handling, routes %T do not implement AbortRoutes",
+		fnType,
+		routes,
+	)}
+}
+
+func newUnsupportedScopeRoutesError(routes Routes, fnType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot register middleware %s returning value, routes %T do not implement ScopeRoutes",
+		fnType,
+		routes,
+	)}
+}
+
+func newMissingContextScopeError(valueType reflect.Type) Error {
+	return Error{fmt.Sprintf("cannot resolve value for type %s, context holds no request scope", valueType)}
+}
+
+func newUnprovidedScopeValueError(valueType reflect.Type) Error {
+	return Error{fmt.Sprintf("value for type %s was not provided into request scope by middleware", valueType)}
+}
+
+func newUnsupportedBindingRoutesError(routes Routes, valueType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot bind value for type %s from request body, routes %T do not implement BindingRoutes",
+		valueType,
+		routes,
+	)}
+}
+
+func newUnsupportedParamRoutesError(routes Routes, valueType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot bind value for type %s from request parameters, routes %T do not implement ParamRoutes",
+		valueType,
+		routes,
+	)}
+}
+
+func newInvalidParamFieldError(structType reflect.Type, fieldName string, reason string) Error {
+	return Error{fmt.Sprintf("cannot bind request parameters into %s, field %s %s", structType, fieldName, reason)}
+}
+
+func newInvalidValidationRuleError(structType reflect.Type, fieldName string, rule string) Error {
+	return Error{fmt.Sprintf("cannot validate %s field %s with invalid rule %q", structType, fieldName, rule)}
+}
+
+func newInvalidRouteBindingError(valueType reflect.Type, resolverType reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot register route binding resolver %s, resolver should receive route parameter string "+
+			"as last input value and return %s and error values",
+		resolverType,
+		valueType,
+	)}
+}
+
+func newMissingRouteParamError(valueType reflect.Type, paramName string, route string) Error {
+	return Error{fmt.Sprintf(
+		"cannot inject %s bound from path parameter %q into route %s not declaring the parameter",
+		valueType,
+		paramName,
+		route,
+	)}
+}
+
+func newUnknownRuleFieldError(structType reflect.Type, fieldRules map[string]string) Error {
+	return Error{fmt.Sprintf("cannot validate %s with rules %v of unknown fields", structType, fieldRules)}
+}
+
+func newInvalidFormRequestMethodError(valueType reflect.Type, methodName string, resultType string) Error {
+	return Error{fmt.Sprintf("cannot bind form request %s, %s method should return %s", valueType, methodName, resultType)}
+}
+
+func newInvalidErrorHandlerError(handler interface{}, seedTypes []reflect.Type) Error {
+	return Error{fmt.Sprintf(
+		"cannot set error handler %T, should be function with signature func(ctx, error) where ctx is one of %v",
+		handler,
+		seedTypes,
+	)}
+}
+
+func newRouteConflictError(definition *routeDefinition, existing *routeDefinition) Error {
+	return Error{fmt.Sprintf(
+		"cannot register route %s %s handled by %s, conflicts with route %s %s handled by %s",
+		definition.httpMethod,
+		definition.endPoint,
+		definition.source(),
+		existing.httpMethod,
+		existing.endPoint,
+		existing.source(),
+	)}
+}
+
+func newUnknownControllerMethodExprError(ctrlType reflect.Type, methodExpr reflect.Value) Error {
+	return Error{fmt.Sprintf(
+		"cannot register request handler method expression %s not belonging to controller %s",
+		funcName(methodExpr),
+		ctrlType,
+	)}
+}
+
+func newInvalidRouteSpecError(routeSpec string) Error {
+	return Error{fmt.Sprintf("cannot register controller route spec %q with unknown http method", routeSpec)}
+}
+
+func newUninferableHTTPMethodError(ctrlType reflect.Type, methodName string) Error {
+	return Error{fmt.Sprintf(
+		"cannot infer http method from request handler method %s name for controller %s",
+		methodName,
+		ctrlType,
+	)}
+}
+
 func newCannotRegisterProvidersError(providers []Provider) Error {
 	providerMessages := make([]string, 0)
 
@@ -53,6 +240,14 @@ func newInvalidHandlerFnParamCountError() Error {
 	return Error{"function passed as routes request handler should have one context parameter"}
 }
 
+func newUnsupportedGroupRoutesError(routes Routes) Error {
+	return Error{fmt.Sprintf("routes %T do not support route groups", routes)}
+}
+
+func newNoContextSeedTypeError(seedTypes []reflect.Type) Error {
+	return Error{fmt.Sprintf("none of routes seed types %v implement context.Context interface", seedTypes)}
+}
+
 func newInvalidContextTypeError(contextType reflect.Type) Error {
 	return Error{fmt.Sprintf(
 		"routes request handler context parameter(%s) does not implement context.Context interface",
@@ -0,0 +1,113 @@
+package injection
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+)
+
+// FormRequest can be embedded into request handler input value struct types, marking the type as form request
+// bound from request. Form request types can declare methods called after the value is bound:
+// - Authorize should return bool, request handling is aborted with 403 status code when it returns false
+// - Rules should return map of field names to validate tag rules, checked before Injector Validator
+// form request methods receive injected input values, same as request handler methods.
+// Form request value is bound from request parameters when the type embeds Params, from request body otherwise
+type FormRequest struct{}
+
+const (
+	authorizeMethod = "Authorize"
+	rulesMethod     = "Rules"
+)
+
+var errUnauthorizedFormRequest = errors.New("request is not authorized")
+
+// isFormRequest reports whether given struct type or pointer to struct type embeds FormRequest
+func isFormRequest(valueType reflect.Type) bool {
+	if valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	if valueType.Kind() != reflect.Struct {
+		return false
+	}
+
+	formRequestType := reflect.TypeOf(FormRequest{})
+
+	for i := 0; i < valueType.NumField(); i++ {
+		if field := valueType.Field(i); field.Anonymous && field.Type == formRequestType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// formRequestProvider returns value provider binding form request from request, authorizing the request and
+// checking form request rules, provider depends on form request methods input values.
+// Panics when form request methods return unsupported values or Injector Routes do not support binding the type
+func (r *Injector) formRequestProvider(valueType reflect.Type) *providerDefinition {
+	ptrType := valueType
+
+	if valueType.Kind() != reflect.Ptr {
+		ptrType = reflect.PtrTo(valueType)
+	}
+
+	var bind binder
+
+	if isParams(valueType) {
+		bind = r.paramsBinder(valueType)
+	} else {
+		bind = r.bodyBinder(valueType)
+	}
+
+	var dependencyTypes []reflect.Type
+
+	authorize, hasAuthorize := ptrType.MethodByName(authorizeMethod)
+
+	if hasAuthorize {
+		if authorize.Type.NumOut() != 1 || authorize.Type.Out(0).Kind() != reflect.Bool {
+			panic(newInvalidFormRequestMethodError(valueType, authorizeMethod, "bool"))
+		}
+
+		dependencyTypes = append(dependencyTypes, fnParamTypes(authorize.Type, 1)...)
+	}
+
+	authorizeDependencyCount := len(dependencyTypes)
+	rules, hasRules := ptrType.MethodByName(rulesMethod)
+
+	if hasRules {
+		if rules.Type.NumOut() != 1 || rules.Type.Out(0) != reflect.TypeOf(map[string]string{}) {
+			panic(newInvalidFormRequestMethodError(valueType, rulesMethod, "map[string]string"))
+		}
+
+		dependencyTypes = append(dependencyTypes, fnParamTypes(rules.Type, 1)...)
+	}
+
+	return r.boundProvider(valueType, dependencyTypes, func(target reflect.Value, seeds, dependencies []reflect.Value) {
+		bind(target, seeds, nil)
+
+		if hasAuthorize {
+			args := append([]reflect.Value{target}, dependencies[:authorizeDependencyCount]...)
+
+			if !authorize.Func.Call(args)[0].Bool() {
+				panic(requestFailure{NewRequestError(http.StatusForbidden, errUnauthorizedFormRequest)})
+			}
+		}
+
+		if hasRules {
+			args := append([]reflect.Value{target}, dependencies[authorizeDependencyCount:]...)
+			fieldRules := rules.Func.Call(args)[0].Interface().(map[string]string)
+
+			// rules returned by Rules method may depend on request, they are compiled on every request
+			compiledRules, err := compileRules(ptrType.Elem(), fieldRules)
+
+			if err != nil {
+				panic(requestFailure{err})
+			}
+
+			if err := compiledRules.check(target.Elem()); err != nil {
+				panic(requestFailure{err})
+			}
+		}
+	})
+}
@@ -3,9 +3,14 @@ package gin
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/surmus/injection"
+	"net/http"
 	"reflect"
+	"strings"
 )
 
+// scopeKey is gin context key of request scope shared by all request handlers of the request
+const scopeKey = "github.com/surmus/injection.Scope"
+
 type adapter struct {
 	ginRoutesValue reflect.Value
 	handlerFnType  reflect.Type
@@ -68,6 +73,87 @@ func (r *adapter) Handle(httpMethod string, endPoint string, handlerFnValues ...
 	return newAdapter(fnCallResult.Interface().(gin.IRoutes))
 }
 
+func (r *adapter) Group(prefix string, handlerFnValues ...reflect.Value) injection.Routes {
+	groupFn := r.ginRoutesValue.MethodByName("Group")
+
+	// gin.IRoutes implementations other than gin.IRouter do not support groups
+	if !groupFn.IsValid() {
+		return nil
+	}
+
+	groupFnInputValues := append([]reflect.Value{reflect.ValueOf(prefix)}, handlerFnValues...)
+	fnCallResult := groupFn.Call(groupFnInputValues)[0]
+
+	return newAdapter(fnCallResult.Interface().(gin.IRoutes))
+}
+
 func (r *adapter) HandlerFnType() reflect.Type {
 	return r.handlerFnType
 }
+
+// Router returns gin.Engine routes are registered into along with routes base path,
+// routes of gin.IRoutes implementations other than gin.Engine and gin.RouterGroup are not shared
+func (r *adapter) Router() (interface{}, string) {
+	var group *gin.RouterGroup
+
+	switch ginRoutes := r.ginRoutesValue.Interface().(type) {
+	case *gin.Engine:
+		group = &ginRoutes.RouterGroup
+	case *gin.RouterGroup:
+		group = ginRoutes
+	default:
+		return nil, ""
+	}
+
+	// gin.RouterGroup does not expose gin.Engine it registers routes into
+	return reflect.ValueOf(group).Elem().FieldByName("engine").UnsafePointer(), strings.TrimSuffix(group.BasePath(), "/")
+}
+
+func (r *adapter) Bind(seeds []reflect.Value, target interface{}) error {
+	return seeds[0].Interface().(*gin.Context).ShouldBind(target)
+}
+
+func (r *adapter) Param(seeds []reflect.Value, source string, name string) []string {
+	ctx := seeds[0].Interface().(*gin.Context)
+
+	switch source {
+	case injection.PathParam:
+		if value, exists := ctx.Params.Get(name); exists {
+			return []string{value}
+		}
+	case injection.QueryParam:
+		return ctx.QueryArray(name)
+	case injection.HeaderParam:
+		return ctx.Request.Header.Values(name)
+	case injection.CookieParam:
+		if value, err := ctx.Cookie(name); err == nil {
+			return []string{value}
+		}
+	}
+
+	return nil
+}
+
+func (r *adapter) Scope(seeds []reflect.Value) *injection.Scope {
+	if scope, exists := seeds[0].Interface().(*gin.Context).Get(scopeKey); exists {
+		return scope.(*injection.Scope)
+	}
+
+	return nil
+}
+
+func (r *adapter) SetScope(seeds []reflect.Value, scope *injection.Scope) []reflect.Value {
+	ctx := seeds[0].Interface().(*gin.Context)
+	ctx.Set(scopeKey, scope)
+	ctx.Request = ctx.Request.WithContext(injection.ContextWithScope(ctx.Request.Context(), scope))
+
+	return seeds
+}
+
+func (r *adapter) Abort(seeds []reflect.Value) {
+	seeds[0].Interface().(*gin.Context).Abort()
+}
+
+func (r *adapter) ResponseWriter(seeds []reflect.Value) http.ResponseWriter {
+	return seeds[0].Interface().(*gin.Context).Writer
+}
@@ -1,11 +1,15 @@
 package gin
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/surmus/injection"
 	"github.com/surmus/injection/test"
 	"net/http"
+	"sync"
 	"testing"
 )
 
@@ -113,6 +117,274 @@ func (c *InvalidRoutesMapController) Routes() map[string][]string {
 	return map[string][]string{ctrlPostEndpoint: {"PostTest"}}
 }
 
+type RouteSpecController struct {
+	injection.BaseController
+}
+
+func (c *RouteSpecController) Routes() map[string][]string {
+	return map[string][]string{
+		"POST /articles/:id/publish": {"PublishArticle"},
+		"/articles/:id/postpone":     {"Postpone"},
+	}
+}
+
+func (c *RouteSpecController) PublishArticle(context *gin.Context) {
+	context.String(http.StatusTeapot, "%s", context.Param("id"))
+}
+
+func (c *RouteSpecController) Postpone(context *gin.Context) {
+	context.String(http.StatusTeapot, "%s", test.Response)
+}
+
+func (c RouteSpecController) ShowArticle(context *gin.Context) {
+	context.String(http.StatusTeapot, "%s", context.Param("id"))
+}
+
+type AdminController struct {
+	executed *[]string
+}
+
+func (c *AdminController) BasePath() string {
+	return "/admin"
+}
+
+func (c *AdminController) ControllerMiddleware() []injection.Handler {
+	return []injection.Handler{func(ctx *gin.Context) {
+		*c.executed = append(*c.executed, "controller")
+	}}
+}
+
+func (c *AdminController) Middleware() map[string][]injection.Handler {
+	return map[string][]injection.Handler{"DeleteUser": {func(ctx *gin.Context) {
+		*c.executed = append(*c.executed, "method")
+	}}}
+}
+
+func (c *AdminController) Routes() map[string][]string {
+	return map[string][]string{"/users/:id": {"DeleteUser"}}
+}
+
+func (c *AdminController) DeleteUser(context *gin.Context) {
+	*c.executed = append(*c.executed, "handler")
+
+	context.String(http.StatusTeapot, "%s", context.Param("id"))
+}
+
+type ActionHookController struct {
+	injection.BaseController
+
+	executed *[]string
+	abort    bool
+	user     string
+}
+
+func (c *ActionHookController) Routes() map[string][]string {
+	return map[string][]string{"/users/:id": {"GetUser"}}
+}
+
+func (c *ActionHookController) BeforeAction(context *gin.Context, constant string) bool {
+	*c.executed = append(*c.executed, "before")
+	c.user = context.Param("id") + constant
+
+	return !c.abort
+}
+
+func (c *ActionHookController) GetUser(context *gin.Context) {
+	*c.executed = append(*c.executed, "handler")
+
+	context.String(http.StatusOK, "%s", c.user)
+}
+
+func (c *ActionHookController) AfterAction() {
+	*c.executed = append(*c.executed, "after")
+}
+
+type ErrorActionHookController struct {
+	injection.BaseController
+
+	executed *[]string
+}
+
+func (c *ErrorActionHookController) Routes() map[string][]string {
+	return map[string][]string{"/users": {"GetUsers"}}
+}
+
+func (c *ErrorActionHookController) BeforeAction() error {
+	return errors.New("not authorized")
+}
+
+func (c *ErrorActionHookController) GetUsers() {
+	*c.executed = append(*c.executed, "handler")
+}
+
+type InvalidActionHookController struct {
+	ErrorActionHookController
+}
+
+func (c *InvalidActionHookController) AfterAction() bool {
+	return true
+}
+
+type RenderedController struct {
+	injection.BaseController
+}
+
+func (c *RenderedController) Routes() map[string][]string {
+	return map[string][]string{"/users/:id": {"GetUser", "DeleteUser"}}
+}
+
+func (c *RenderedController) GetUser(context *gin.Context) (*renderedUser, error) {
+	if context.Param("id") == "0" {
+		return nil, errors.New("user not found")
+	}
+
+	return &renderedUser{ID: context.Param("id")}, nil
+}
+
+func (c *RenderedController) DeleteUser() error {
+	return nil
+}
+
+type renderedUser struct {
+	ID string `json:"id"`
+}
+
+type createUserRequest struct {
+	injection.Body
+
+	Name string `json:"name" xml:"name" form:"name"`
+}
+
+type credentials struct {
+	Login string `json:"login"`
+}
+
+type userParams struct {
+	injection.Params
+
+	ID      int      `path:"id"`
+	Page    int      `query:"page" default:"1"`
+	Tags    []string `query:"tag"`
+	Key     string   `header:"X-Api-Key,required"`
+	Session *string  `cookie:"session"`
+}
+
+type invalidParams struct {
+	injection.Params
+
+	Filter map[string]string `query:"filter"`
+}
+
+type ParamsController struct {
+	injection.BaseController
+}
+
+func (c *ParamsController) Routes() map[string][]string {
+	return map[string][]string{"/users/:id": {"GetUser"}}
+}
+
+func (c *ParamsController) GetUser(params *userParams) (int, *userParams) {
+	return http.StatusOK, params
+}
+
+type signUpRequest struct {
+	injection.Body
+
+	Login string `json:"login" validate:"required,min=3"`
+	Role  string `json:"role" validate:"oneof=admin user"`
+	Age   *int   `json:"age" validate:"min=18"`
+}
+
+func (r *signUpRequest) Validate() error {
+	if r.Login == r.Role {
+		return errors.New("login cannot match role")
+	}
+
+	return nil
+}
+
+type updateUserRequest struct {
+	injection.FormRequest
+
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+func (r *updateUserRequest) Authorize(ctx *gin.Context, constant string) bool {
+	return ctx.GetHeader("X-Api-Key") == constant
+}
+
+func (r *updateUserRequest) Rules(ctx *gin.Context) map[string]string {
+	if ctx.Param("id") == "admin" {
+		return map[string]string{"name": "required", "Role": "oneof=admin"}
+	}
+
+	return map[string]string{"name": "required,min=2"}
+}
+
+type FormRequestController struct {
+	injection.BaseController
+}
+
+func (c *FormRequestController) Routes() map[string][]string {
+	return map[string][]string{"/users/:id": {"PutUser"}}
+}
+
+func (c *FormRequestController) PutUser(request *updateUserRequest) *updateUserRequest {
+	return request
+}
+
+type invalidFormRequest struct {
+	injection.FormRequest
+}
+
+type authorizedDependency struct{}
+
+func (d *authorizedDependency) Authorize(ctx *gin.Context) bool {
+	return true
+}
+
+func (r invalidFormRequest) Authorize() string {
+	return ""
+}
+
+type unavailableDependency struct{}
+
+type principal struct {
+	Name string
+}
+
+type principalName struct {
+	Name string
+}
+
+func (n *principalName) String() string {
+	return n.Name
+}
+
+type validatorFunc func(value interface{}) error
+
+func (fn validatorFunc) Validate(value interface{}) error {
+	return fn(value)
+}
+
+type textRenderer struct{}
+
+func (textRenderer) Render(writer http.ResponseWriter, status int, value interface{}) error {
+	writer.WriteHeader(status)
+	_, err := fmt.Fprint(writer, value)
+
+	return err
+}
+
+type InvalidRouteSpecController struct {
+	RouteSpecController
+}
+
+func (c *InvalidRouteSpecController) Routes() map[string][]string {
+	return map[string][]string{"PUBLISH /articles/:id": {"PublishArticle"}}
+}
+
 func setupRouterWithProviders() *injection.Injector {
 	valueRequiringContextProvider := func(ctx *gin.Context) *test.DependencyStruct {
 		return &test.DependencyStruct{Ctx: ctx}
@@ -134,6 +406,27 @@ func setupRouterWithProviders() *injection.Injector {
 	return r
 }
 
+// manifestProviderSources returns source locations of value providers described in Injector manifest by their type
+func manifestProviderSources(r *injection.Injector) map[string]string {
+	var document struct {
+		Providers []struct {
+			Type   string `json:"type"`
+			Source string `json:"source"`
+		} `json:"providers"`
+	}
+
+	manifestJSON, _ := r.Manifest()
+	test.MustUnMarshal(manifestJSON, &document)
+
+	sources := map[string]string{}
+
+	for _, provider := range document.Providers {
+		sources[provider.Type] = provider.Source
+	}
+
+	return sources
+}
+
 func setupTestHandlerFn(t *testing.T) interface{} {
 	return func(
 		context *gin.Context,
@@ -397,6 +690,37 @@ func TestIRoutesImpl_Use(t *testing.T) {
 			assert.Equal(t, http.StatusTeapot, req.Response.Code)
 			assert.Equal(t, test.Response, string(req.Response.Body.Bytes()))
 		},
+		"share request scoped values between middleware and request handlers of the request": func(t *testing.T) {
+			var resolved []*test.DependencyStruct
+			providerCalls := 0
+			r := setupRouterWithProviders()
+			r.RegisterProviders(func(ctx *gin.Context) *test.DependencyStruct {
+				providerCalls++
+
+				return &test.DependencyStruct{Ctx: ctx}
+			})
+
+			registrationError := r.Use(func(dependency *test.DependencyStruct) {
+				resolved = append(resolved, dependency)
+			})
+			group := r.Group("/group", func(dependency test.DependencyInterface) {
+				resolved = append(resolved, dependency.(*test.DependencyStruct))
+			})
+			group.GET(test.Endpoint, func(c *gin.Context, dependency *test.DependencyStruct) {
+				resolved = append(resolved, dependency)
+
+				c.Status(http.StatusTeapot)
+			})
+
+			req := test.NewRequest("/group"+test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, 1, providerCalls)
+			assert.Len(t, resolved, 3)
+			assert.Same(t, resolved[0], resolved[1])
+			assert.Same(t, resolved[0], resolved[2])
+		},
 		"fail to register handler with unregistered dependencies": func(t *testing.T) {
 			r := Adapt(gin.New())
 
@@ -414,7 +738,7 @@ func TestIRoutesImpl_Use(t *testing.T) {
 }
 
 func TestIRoutesImpl_RegisterController(t *testing.T) {
-	tests := map[string]func(t *testing.T){
+	testCases := map[string]func(t *testing.T){
 		"successfully for Controller pointer receiver request handler method": func(t *testing.T) {
 			r := setupRouterWithProviders()
 
@@ -463,15 +787,153 @@ func TestIRoutesImpl_RegisterController(t *testing.T) {
 
 			assert.IsType(t, injection.Error{}, registrationError)
 		},
+		"successfully for Controller routes with explicit http method route spec": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.RegisterController(new(RouteSpecController))
+
+			publishReq := test.NewRequest("/articles/42/publish", http.MethodPost).MustBuild().Do(test.Router)
+			postponeReq := test.NewRequest("/articles/42/postpone", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, publishReq.Response.Code)
+			assert.Equal(t, "42", string(publishReq.Response.Body.Bytes()))
+			assert.Equal(t, http.StatusTeapot, postponeReq.Response.Code)
+		},
+		"fail registering Controller with unknown route spec http method": func(t *testing.T) {
+			r := Adapt(gin.New())
+
+			registrationError := r.RegisterController(new(InvalidRouteSpecController))
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+		"successfully for Controller routes declared with method expressions": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			routeMiddlewareExecuted := false
+
+			registrationError := r.RegisterController(
+				NewValueController(t),
+				injection.Route(http.MethodPut, "/typed", ValueController.GetTest, func(c *gin.Context) {
+					routeMiddlewareExecuted = true
+				}),
+			)
+
+			req := test.NewRequest("/typed", http.MethodPut).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.True(t, middlewareFnExecuted, "controller method middleware should be executed")
+			assert.True(t, routeMiddlewareExecuted)
+		},
+		"successfully for pointer Controller routes declared with value receiver method expression": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.RegisterController(
+				&RouteSpecController{},
+				injection.Route(http.MethodPut, "/articles/:id", RouteSpecController.ShowArticle),
+			)
+
+			req := test.NewRequest("/articles/42", http.MethodPut).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, "42", string(req.Response.Body.Bytes()))
+		},
+		"fail registering Controller route declared with method expression of another type": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.RegisterController(
+				NewPointerController(t),
+				injection.Route(http.MethodPost, "/typed", (*RouteSpecController).PublishArticle),
+			)
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+		"should prefix Controller routes with base path and execute Controller middleware first": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			var executed []string
+
+			registrationError := r.RegisterController(&AdminController{executed: &executed})
+
+			req := test.NewRequest("/admin/users/42", http.MethodDelete).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, "42", string(req.Response.Body.Bytes()))
+			assert.Equal(t, []string{"controller", "method", "handler"}, executed)
+		},
+		"successfully call Controller action hooks around request handler method": func(t *testing.T) {
+			var executed []string
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(&ActionHookController{executed: &executed})
+
+			req := test.NewRequest("/users/42", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Equal(t, "42"+test.Constant, string(req.Response.Body.Bytes()))
+			assert.Equal(t, []string{"before", "handler", "after"}, executed)
+		},
+		"successfully abort request handler method when BeforeAction returns false": func(t *testing.T) {
+			var executed []string
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(&ActionHookController{executed: &executed, abort: true})
+
+			req := test.NewRequest("/users/42", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusForbidden, req.Response.Code)
+			assert.Equal(t, "application/problem+json", req.Response.Header().Get("Content-Type"))
+			assert.JSONEq(
+				t,
+				`{"type":"about:blank","title":"Forbidden","status":403,"detail":"request handling is aborted by BeforeAction"}`,
+				req.Response.Body.String(),
+			)
+			assert.Equal(t, []string{"before"}, executed)
+		},
+		"successfully abort request handler method when BeforeAction returns error": func(t *testing.T) {
+			var executed []string
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(&ErrorActionHookController{executed: &executed})
+
+			req := test.NewRequest("/users", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusInternalServerError, req.Response.Code)
+			assert.Equal(t, "application/problem+json", req.Response.Header().Get("Content-Type"))
+			assert.Empty(t, executed)
+		},
+		"fail registering Controller with invalid action hook return values": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.RegisterController(new(InvalidActionHookController))
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+		"fail registering Controller with uninferable http method in strict mode": func(t *testing.T) {
+			r := Adapt(gin.New())
+			r.StrictRoutes(true)
+
+			registrationError := r.RegisterController(new(RouteSpecController))
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+		"successfully for Controller with http method prefixed method names in strict mode": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.StrictRoutes(true)
+
+			registrationError := r.Group("/v1").RegisterController(NewPointerController(t))
+
+			assert.Nil(t, registrationError)
+		},
 	}
 
-	for testName, testCase := range tests {
+	for testName, testCase := range testCases {
 		t.Run(testName, testCase)
 	}
 }
 
 func TestAdaptToExisting(t *testing.T) {
-	tests := map[string]func(t *testing.T){
+	testCases := map[string]func(t *testing.T){
 		"verify injector copy middleware does not bleed over to original": func(t *testing.T) {
 			test.Init()
 
@@ -512,9 +974,1203 @@ func TestAdaptToExisting(t *testing.T) {
 
 			assert.Equal(t, 2, triggeredMiddleWaresCount)
 		},
+		"fail to register route conflicting with route of original injector router": func(t *testing.T) {
+			test.Init()
+
+			originalInjector := Adapt(test.Router)
+			originalCpy := AdaptToExisting(originalInjector, test.Router.Group("/users"))
+
+			assert.Nil(t, originalInjector.GET("/users/:id", func() {}))
+			assert.IsType(t, injection.Error{}, originalCpy.GET("/:name/posts", func() {}))
+			assert.Nil(t, originalCpy.GET("/:id/posts", func() {}))
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
 	}
+}
+
+func TestInjector_Group(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"should register group routes under prefix with group middleware": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			var executed []string
+
+			group := r.Group("/v1", func(c *gin.Context, providedConstant string) {
+				executed = append(executed, "group")
+			})
+			registrationError := group.Handle(http.MethodGet, test.Endpoint, func(c *gin.Context) {
+				executed = append(executed, "handler")
+
+				c.String(http.StatusTeapot, "%s", test.Response)
+			})
+
+			req := test.NewRequest("/v1"+test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, []string{"group", "handler"}, executed)
+		},
+		"should override inherited providers only for group routes": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			group := r.Group("/v1")
+			group.RegisterProviders(func() string { return "GROUP-CONSTANT" })
+
+			group.Handle(http.MethodGet, test.Endpoint, func(c *gin.Context, providedConstant string) {
+				c.String(http.StatusOK, "%s", providedConstant)
+			})
+			r.Handle(http.MethodGet, test.Endpoint, func(c *gin.Context, providedConstant string) {
+				c.String(http.StatusOK, "%s", providedConstant)
+			})
+
+			groupReq := test.NewRequest("/v1"+test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, "GROUP-CONSTANT", string(groupReq.Response.Body.Bytes()))
+			assert.Equal(t, test.Constant, string(req.Response.Body.Bytes()))
+		},
+		"should resolve overridden providers for group routes behind root middleware": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			var middlewareConstant string
 
-	for testName, testCase := range tests {
+			r.Use(func(providedConstant string) {
+				middlewareConstant = providedConstant
+			})
+
+			group := r.Group("/v1")
+			group.RegisterProviders(func() string { return "GROUP-CONSTANT" })
+
+			group.Handle(http.MethodGet, test.Endpoint, func(c *gin.Context, providedConstant string) {
+				c.String(http.StatusOK, "%s", providedConstant)
+			})
+
+			groupReq := test.NewRequest("/v1"+test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, test.Constant, middlewareConstant)
+			assert.Equal(t, "GROUP-CONSTANT", string(groupReq.Response.Body.Bytes()))
+		},
+		"should register nested group Controller under prefixes": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.Group("/api").Group("/v1").RegisterController(NewPointerController(t))
+
+			req := test.NewRequest("/api/v1"+ctrlPostEndpoint, http.MethodPost).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, test.Response, string(req.Response.Body.Bytes()))
+		},
+		"should panic when group middleware has unregistered dependencies": func(t *testing.T) {
+			r := Adapt(gin.New())
+
+			assert.Panics(t, func() {
+				r.Group("/v1", func(dependency *test.DependencyStruct) {})
+			})
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_HTTPMethodHelpers(t *testing.T) {
+	helpers := map[string]func(r *injection.Injector) func(string, ...injection.Handler) error{
+		http.MethodGet:     func(r *injection.Injector) func(string, ...injection.Handler) error { return r.GET },
+		http.MethodPost:    func(r *injection.Injector) func(string, ...injection.Handler) error { return r.POST },
+		http.MethodPut:     func(r *injection.Injector) func(string, ...injection.Handler) error { return r.PUT },
+		http.MethodPatch:   func(r *injection.Injector) func(string, ...injection.Handler) error { return r.PATCH },
+		http.MethodDelete:  func(r *injection.Injector) func(string, ...injection.Handler) error { return r.DELETE },
+		http.MethodHead:    func(r *injection.Injector) func(string, ...injection.Handler) error { return r.HEAD },
+		http.MethodOptions: func(r *injection.Injector) func(string, ...injection.Handler) error { return r.OPTIONS },
+	}
+
+	for httpMethod, helper := range helpers {
+		t.Run(httpMethod, func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := helper(r)(test.Endpoint, setupTestHandlerFn(t))
+			unregisteredDependencyErr := helper(Adapt(gin.New()))(test.Endpoint, setupTestHandlerFn(t))
+
+			req := test.NewRequest(test.Endpoint, httpMethod).
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.IsType(t, injection.Error{}, unregisteredDependencyErr)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+		})
+	}
+}
+
+func TestInjector_Any(t *testing.T) {
+	r := setupRouterWithProviders()
+
+	registrationError := r.Any(test.Endpoint, setupTestHandlerFn(t))
+
+	assert.Nil(t, registrationError)
+
+	for _, httpMethod := range []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodTrace} {
+		req := test.NewRequest(test.Endpoint, httpMethod).
+			MustBuild().Do(test.Router)
+
+		assert.Equal(t, http.StatusTeapot, req.Response.Code, httpMethod)
+	}
+
+	assert.IsType(t, injection.Error{}, Adapt(gin.New()).Any(test.Endpoint, setupTestHandlerFn(t)))
+}
+
+func TestInjector_RenderResults(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"successfully render handler return value as JSON": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.GET(test.Endpoint, func(constant string) *renderedUser {
+				return &renderedUser{ID: constant}
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Equal(t, "application/json; charset=utf-8", req.Response.Header().Get("Content-Type"))
+			assert.JSONEq(t, `{"id":"`+test.Constant+`"}`, string(req.Response.Body.Bytes()))
+		},
+		"successfully render handler return value with returned status code": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.POST(test.Endpoint, func() (int, *renderedUser) {
+				return http.StatusCreated, &renderedUser{ID: "42"}
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusCreated, req.Response.Code)
+			assert.JSONEq(t, `{"id":"42"}`, string(req.Response.Body.Bytes()))
+		},
+		"successfully pass handler returned error to error handler": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.GET(test.Endpoint, func() (*renderedUser, error) {
+				return nil, errors.New("failed")
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusInternalServerError, req.Response.Code)
+		},
+		"successfully render Controller method return values": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(new(RenderedController))
+
+			req := test.NewRequest("/users/42", http.MethodGet).MustBuild().Do(test.Router)
+			notFoundReq := test.NewRequest("/users/0", http.MethodGet).MustBuild().Do(test.Router)
+			deleteReq := test.NewRequest("/users/42", http.MethodDelete).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.JSONEq(t, `{"id":"42"}`, string(req.Response.Body.Bytes()))
+			assert.Equal(t, http.StatusInternalServerError, notFoundReq.Response.Code)
+			assert.Equal(t, http.StatusOK, deleteReq.Response.Code)
+			assert.Empty(t, deleteReq.Response.Body.Bytes())
+		},
+		"successfully render handler return value with custom Renderer": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.SetRenderer(textRenderer{})
+
+			registrationError := r.Group("/v1").GET(test.Endpoint, func() (int, string) {
+				return http.StatusTeapot, test.Response
+			})
+
+			req := test.NewRequest("/v1"+test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, test.Response, string(req.Response.Body.Bytes()))
+		},
+		"fail to register handler with unsupported return values": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.GET(test.Endpoint, func() (string, string) { return "", "" })
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_BindBody(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"describe bound values without source location in manifest": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.POST(test.Endpoint, func(request createUserRequest, requestPtr *createUserRequest) {})
+			sources := manifestProviderSources(r)
+
+			assert.Nil(t, registrationError)
+			assert.Contains(t, sources, "gin.createUserRequest")
+			assert.Contains(t, sources, "*gin.createUserRequest")
+			assert.Empty(t, sources["gin.createUserRequest"])
+			assert.Empty(t, sources["*gin.createUserRequest"])
+		},
+		"successfully bind request body into types embedding Body": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.POST(test.Endpoint, func(request createUserRequest) string {
+				return request.Name
+			})
+
+			jsonReq := test.NewRequest(test.Endpoint, http.MethodPost).
+				Header("Content-Type", "application/json").
+				Body(`{"name":"json"}`).MustBuild().Do(test.Router)
+			xmlReq := test.NewRequest(test.Endpoint, http.MethodPost).
+				Header("Content-Type", "application/xml").
+				Body(`<request><name>xml</name></request>`).MustBuild().Do(test.Router)
+			formReq := test.NewRequest(test.Endpoint, http.MethodPost).
+				Header("Content-Type", "application/x-www-form-urlencoded").
+				Body("name=form").MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, `"json"`, string(jsonReq.Response.Body.Bytes()))
+			assert.Equal(t, `"xml"`, string(xmlReq.Response.Body.Bytes()))
+			assert.Equal(t, `"form"`, string(formReq.Response.Body.Bytes()))
+		},
+		"successfully bind request body into types registered with RegisterBindable": func(t *testing.T) {
+			injection.RegisterBindable[credentials]()
+			r := setupRouterWithProviders()
+
+			registrationError := r.POST(test.Endpoint, func(credentials *credentials) string {
+				return credentials.Login
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).
+				Header("Content-Type", "application/json").
+				Body(`{"login":"user"}`).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Equal(t, `"user"`, string(req.Response.Body.Bytes()))
+		},
+		"bind request body once for handlers injecting both bound type and pointer to it": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			var middlewareRequest *createUserRequest
+
+			registrationError := r.POST(test.Endpoint, func(request *createUserRequest) {
+				middlewareRequest = request
+			}, func(request createUserRequest) string {
+				return request.Name
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).
+				Header("Content-Type", "application/json").
+				Body(`{"name":"json"}`).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Equal(t, `"json"`, string(req.Response.Body.Bytes()))
+			assert.Equal(t, "json", middlewareRequest.Name)
+		},
+		"respond with 400 status code for malformed request body": func(t *testing.T) {
+			var handlerCalled bool
+			r := setupRouterWithProviders()
+
+			registrationError := r.POST(test.Endpoint, func(request *createUserRequest) {
+				handlerCalled = true
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).
+				Header("Content-Type", "application/json").
+				Body(`{"name":`).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusBadRequest, req.Response.Code)
+			assert.False(t, handlerCalled)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_BindParams(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"describe bound parameters without source location in manifest": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.GET("/users/:id", func(params userParams) {})
+			sources := manifestProviderSources(r)
+
+			assert.Nil(t, registrationError)
+			assert.Contains(t, sources, "gin.userParams")
+			assert.Empty(t, sources["gin.userParams"])
+			assert.Empty(t, sources["*gin.userParams"])
+		},
+		"successfully bind request path, query, header and cookie values": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.GET("/users/:id", func(params userParams) string {
+				return fmt.Sprintf("%d %d %v %s %s", params.ID, params.Page, params.Tags, params.Key, *params.Session)
+			})
+
+			req := test.NewRequest("/users/42?tag=a&tag=b", http.MethodGet).
+				Header("X-Api-Key", "key").
+				Header("Cookie", "session=abc").
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Equal(t, `"42 1 [a b] key abc"`, string(req.Response.Body.Bytes()))
+		},
+		"successfully bind request values for Controller method": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(new(ParamsController))
+
+			req := test.NewRequest("/users/42?page=3", http.MethodGet).
+				Header("X-Api-Key", "key").
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.JSONEq(
+				t,
+				`{"ID":42,"Page":3,"Tags":null,"Key":"key","Session":null}`,
+				string(req.Response.Body.Bytes()),
+			)
+		},
+		"respond with 400 status code for missing required value": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(new(ParamsController))
+
+			req := test.NewRequest("/users/42", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusBadRequest, req.Response.Code)
+			assert.JSONEq(
+				t,
+				`{"type":"about:blank","title":"Bad Request","status":400,
+				"detail":"missing required header parameter \"X-Api-Key\""}`,
+				string(req.Response.Body.Bytes()),
+			)
+		},
+		"respond with 400 status code for value not convertible into field type": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(new(ParamsController))
+
+			req := test.NewRequest("/users/abc", http.MethodGet).
+				Header("X-Api-Key", "key").
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusBadRequest, req.Response.Code)
+			assert.JSONEq(
+				t,
+				`{"type":"about:blank","title":"Bad Request","status":400,
+				"detail":"invalid path parameter \"id\": cannot convert \"abc\" to int"}`,
+				string(req.Response.Body.Bytes()),
+			)
+		},
+		"fail to register handler with unsupported Params field type": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.GET(test.Endpoint, func(params *invalidParams) {})
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_Validate(t *testing.T) {
+	signUp := func(r *injection.Injector) {
+		r.POST(test.Endpoint, func(request *signUpRequest) (int, string) {
+			return http.StatusCreated, request.Login
+		})
+	}
+
+	testCases := map[string]func(t *testing.T){
+		"successfully call handler with valid request value": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			signUp(r)
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).
+				JsonContent(map[string]interface{}{"login": "john", "role": "user", "age": 20}).
+				MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusCreated, req.Response.Code)
+			assert.Equal(t, `"john"`, string(req.Response.Body.Bytes()))
+		},
+		"respond with 422 status code listing field errors of validate tag rules": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			signUp(r)
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).
+				JsonContent(map[string]interface{}{"login": "jo", "role": "owner", "age": 16}).
+				MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusUnprocessableEntity, req.Response.Code)
+			assert.JSONEq(t, `{"type":"about:blank","title":"Unprocessable Entity","status":422,"errors":[
+				{"field":"login","message":"must have length of at least 3"},
+				{"field":"role","message":"must be one of admin user"},
+				{"field":"age","message":"must have value of at least 18"}
+			]}`, string(req.Response.Body.Bytes()))
+		},
+		"respond with 422 status code for Validatable error": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			signUp(r)
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).
+				JsonContent(map[string]interface{}{"login": "user", "role": "user"}).
+				MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusUnprocessableEntity, req.Response.Code)
+			assert.JSONEq(t, `{"type":"about:blank","title":"Unprocessable Entity","status":422,"errors":[{"message":"login cannot match role"}]}`, string(req.Response.Body.Bytes()))
+		},
+		"fail to register handler with bound value of invalid validate tag rules": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			unknownRuleErr := r.POST(test.Endpoint, func(request *struct {
+				injection.Body
+
+				Email string `json:"email" validate:"email"`
+			}) {
+			})
+			unsupportedRuleErr := r.PUT(test.Endpoint, func(request struct {
+				injection.Body
+
+				Admin bool `json:"admin" validate:"min=1"`
+			}) {
+			})
+
+			assert.IsType(t, injection.Error{}, unknownRuleErr)
+			assert.Contains(t, unknownRuleErr.Error(), `invalid rule "email"`)
+			assert.IsType(t, injection.Error{}, unsupportedRuleErr)
+		},
+		"successfully validate values with custom Validator": func(t *testing.T) {
+			var validated interface{}
+			r := setupRouterWithProviders()
+			r.SetValidator(validatorFunc(func(value interface{}) error {
+				validated = value
+
+				return &injection.ValidationError{Errors: []injection.FieldError{{Field: "id", Message: "is taken"}}}
+			}))
+
+			r.GET("/users/:id", func(params *userParams) {})
+
+			req := test.NewRequest("/users/42", http.MethodGet).
+				Header("X-Api-Key", "key").
+				MustBuild().Do(test.Router)
+
+			assert.IsType(t, &userParams{}, validated)
+			assert.Equal(t, http.StatusUnprocessableEntity, req.Response.Code)
+			assert.JSONEq(t, `{"type":"about:blank","title":"Unprocessable Entity","status":422,"errors":[{"field":"id","message":"is taken"}]}`, string(req.Response.Body.Bytes()))
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestBindRoute(t *testing.T) {
+	users := map[string]*renderedUser{"42": {ID: "42"}}
+	resolver := func(ctx *gin.Context, constant string, id string) (*renderedUser, error) {
+		switch id {
+		case "0":
+			return nil, fmt.Errorf("user %s: %w", id, injection.ErrNotFound)
+		case "500":
+			return nil, errors.New("connection lost")
+		}
+
+		return users[id], nil
+	}
+
+	setupRouter := func(t *testing.T) *injection.Injector {
+		r := setupRouterWithProviders()
+
+		assert.Nil(t, injection.BindRoute[*renderedUser](r, "user", resolver))
+		assert.Nil(t, r.GET("/users/:user", func(user *renderedUser) *renderedUser {
+			return user
+		}))
+
+		return r
+	}
+
+	testCases := map[string]func(t *testing.T){
+		"successfully inject value resolved from route parameter": func(t *testing.T) {
+			setupRouter(t)
+
+			req := test.NewRequest("/users/42", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.JSONEq(t, `{"id":"42"}`, string(req.Response.Body.Bytes()))
+		},
+		"respond with 404 status code when resolver returns ErrNotFound or nil value": func(t *testing.T) {
+			setupRouter(t)
+
+			notFoundErrReq := test.NewRequest("/users/0", http.MethodGet).MustBuild().Do(test.Router)
+			nilReq := test.NewRequest("/users/1", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusNotFound, notFoundErrReq.Response.Code)
+			assert.Equal(t, http.StatusNotFound, nilReq.Response.Code)
+		},
+		"pass resolver error to error handler": func(t *testing.T) {
+			setupRouter(t)
+
+			req := test.NewRequest("/users/500", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusInternalServerError, req.Response.Code)
+		},
+		"fail registering resolver with invalid signature": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			err := injection.BindRoute[*renderedUser](r, "user", func(id string) *renderedUser { return nil })
+
+			assert.IsType(t, injection.Error{}, err)
+		},
+		"fail registering handler injecting bound value into route without its path parameter": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			assert.Nil(t, injection.BindRoute[*renderedUser](r, "user", resolver))
+
+			err := r.GET("/users/:id", func(user *renderedUser) *renderedUser {
+				return user
+			})
+
+			assert.IsType(t, injection.Error{}, err)
+		},
+		"inject bound value into group route declaring path parameter in group prefix": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			assert.Nil(t, injection.BindRoute[*renderedUser](r, "user", resolver))
+			assert.Nil(t, r.Group("/accounts/:user").GET("/profile", func(user *renderedUser) *renderedUser {
+				return user
+			}))
+
+			req := test.NewRequest("/accounts/42/profile", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+		},
+		"describe route binding resolver in manifest": func(t *testing.T) {
+			r := setupRouter(t)
+
+			assert.Regexp(t, `^adapter_test\.go:\d+$`, manifestProviderSources(r)["*gin.renderedUser"])
+		},
+		"fail registering resolver with unregistered dependencies": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			err := injection.BindRoute[*renderedUser](r, "user", func(client *http.Client, id string) (*renderedUser, error) {
+				return nil, nil
+			})
+
+			assert.IsType(t, injection.Error{}, err)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_FormRequest(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"describe form request without source location in manifest": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(new(FormRequestController))
+			sources := manifestProviderSources(r)
+
+			assert.Nil(t, registrationError)
+			assert.Contains(t, sources, "*gin.updateUserRequest")
+			assert.Empty(t, sources["*gin.updateUserRequest"])
+		},
+		"successfully call Controller method with authorized and valid form request": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			registrationError := r.RegisterController(new(FormRequestController))
+
+			req := test.NewRequest("/users/42", http.MethodPut).
+				Header("X-Api-Key", test.Constant).
+				JsonContent(map[string]string{"name": "john", "role": "user"}).
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.JSONEq(t, `{"name":"john","role":"user"}`, string(req.Response.Body.Bytes()))
+		},
+		"respond with 403 status code when form request is not authorized": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.RegisterController(new(FormRequestController))
+
+			req := test.NewRequest("/users/42", http.MethodPut).
+				JsonContent(map[string]string{"name": "john"}).
+				MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusForbidden, req.Response.Code)
+		},
+		"respond with 422 status code when form request fails its rules": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.RegisterController(new(FormRequestController))
+
+			req := test.NewRequest("/users/admin", http.MethodPut).
+				Header("X-Api-Key", test.Constant).
+				JsonContent(map[string]string{"role": "user"}).
+				MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusUnprocessableEntity, req.Response.Code)
+			assert.JSONEq(t, `{"type":"about:blank","title":"Unprocessable Entity","status":422,"errors":[
+				{"field":"name","message":"is required"},
+				{"field":"role","message":"must be one of admin"}
+			]}`, string(req.Response.Body.Bytes()))
+		},
+		"fail registering handler with form request of invalid method signature": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.PUT(test.Endpoint, func(request invalidFormRequest) {})
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+		"fail registering handler with unregistered type having form request methods": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.PUT(test.Endpoint, func(dependency *authorizedDependency) {})
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_OnError(t *testing.T) {
+	unavailableProvider := func() *unavailableDependency {
+		panic("connection refused")
+	}
+
+	testCases := map[string]func(t *testing.T){
+		"respond with 500 status code problem details for provider panic": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.RegisterProviders(unavailableProvider)
+			r.GET(test.Endpoint, func(dependency *unavailableDependency) {})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusInternalServerError, req.Response.Code)
+			assert.Equal(t, "application/problem+json", req.Response.Header().Get("Content-Type"))
+			assert.JSONEq(
+				t,
+				`{"type":"about:blank","title":"Internal Server Error","status":500}`,
+				string(req.Response.Body.Bytes()),
+			)
+		},
+		"pass provider panic tagged with resolution stage to error handler": func(t *testing.T) {
+			var handledErr error
+			r := setupRouterWithProviders()
+			r.RegisterProviders(unavailableProvider, func(dependency *unavailableDependency) *test.DependencyStruct {
+				return &test.DependencyStruct{}
+			})
+			registrationError := r.OnError(func(ctx *gin.Context, err error) {
+				handledErr = err
+				ctx.Status(http.StatusServiceUnavailable)
+			})
+			r.GET("/users", func(dependency *test.DependencyStruct) {})
+
+			req := test.NewRequest("/users", http.MethodGet).MustBuild().Do(test.Router)
+
+			var stageErr *injection.StageError
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusServiceUnavailable, req.Response.Code)
+			assert.True(t, errors.As(handledErr, &stageErr))
+			assert.Equal(t, "resolving *gin.unavailableDependency for GET /users", stageErr.Stage)
+			assert.EqualError(
+				t,
+				handledErr,
+				"resolving *gin.unavailableDependency for GET /users: panic: connection refused",
+			)
+		},
+		"pass request handler panic tagged with handling stage to error handler": func(t *testing.T) {
+			var handledErr error
+			handlerErr := errors.New("handler failure")
+			r := setupRouterWithProviders()
+			r.OnError(func(ctx *gin.Context, err error) {
+				handledErr = err
+			})
+			r.POST("/users", func(ctx *gin.Context) {
+				panic(handlerErr)
+			})
+
+			test.NewRequest("/users", http.MethodPost).MustBuild().Do(test.Router)
+
+			var stageErr *injection.StageError
+
+			assert.ErrorIs(t, handledErr, handlerErr)
+			assert.EqualError(t, handledErr, "handling POST /users: panic: handler failure")
+			assert.True(t, errors.As(handledErr, &stageErr))
+			assert.Contains(t, string(stageErr.Stack), "TestInjector_OnError")
+		},
+		"pass http.ErrAbortHandler panic on to http server": func(t *testing.T) {
+			var handledErr error
+			router := gin.New()
+			r := Adapt(router)
+			r.OnError(func(ctx *gin.Context, err error) {
+				handledErr = err
+			})
+			r.POST("/users", func(ctx *gin.Context) {
+				panic(http.ErrAbortHandler)
+			})
+
+			assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+				test.NewRequest("/users", http.MethodPost).MustBuild().Do(router)
+			})
+			assert.Nil(t, handledErr)
+		},
+		"pass Controller request handler method error tagged with handling stage to error handler": func(t *testing.T) {
+			var handledErr error
+			r := setupRouterWithProviders()
+			r.OnError(func(ctx *gin.Context, err error) {
+				handledErr = err
+			})
+			r.RegisterController(new(RenderedController))
+
+			test.NewRequest("/users/0", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.EqualError(t, handledErr, "handling GET /users/:id: user not found")
+		},
+		"fail to set error handler with invalid signature": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			assert.IsType(t, injection.Error{}, r.OnError(func(err error) {}))
+			assert.IsType(t, injection.Error{}, r.OnError(func(ctx *http.Request, err error) {}))
+			assert.IsType(t, injection.Error{}, r.OnError(func(ctx *gin.Context, err string) {}))
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_MiddlewareValues(t *testing.T) {
+	authenticate := func(c *gin.Context) *principal {
+		return &principal{Name: c.GetHeader("X-User")}
+	}
+
+	testCases := map[string]func(t *testing.T){
+		"successfully inject value returned by Use middleware": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.Use(authenticate)
+			r.GET(test.Endpoint, func(user *principal) string {
+				return user.Name
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).
+				Header("X-User", "john").
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Equal(t, `"john"`, string(req.Response.Body.Bytes()))
+		},
+		"successfully inject value returned by route middleware into provider dependency": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.Use(authenticate)
+			r.RegisterProviders(func(user *principal) *credentials {
+				return &credentials{Login: user.Name}
+			})
+
+			registrationError := r.GET(test.Endpoint, func(c *gin.Context) *principal {
+				return &principal{Name: "admin"}
+			}, func(login *credentials) string {
+				return login.Login
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).
+				Header("X-User", "john").
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, `"admin"`, string(req.Response.Body.Bytes()))
+		},
+		"successfully inject value provided into Scope by group middleware": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			group := r.Group("/group", func(c *gin.Context, scope *injection.Scope) {
+				scope.Provide(&principal{Name: c.GetHeader("X-User")})
+			})
+			injection.RegisterScopeValue[*principal](group)
+
+			registrationError := group.GET(test.Endpoint, func(user *principal) string {
+				return user.Name
+			})
+
+			req := test.NewRequest("/group"+test.Endpoint, http.MethodGet).
+				Header("X-User", "john").
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, `"john"`, string(req.Response.Body.Bytes()))
+		},
+		"successfully inject value provided into Scope by interface type": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.Use(func(scope *injection.Scope) {
+				injection.Provide[fmt.Stringer](scope, &principalName{Name: "john"})
+			})
+			injection.RegisterScopeValue[fmt.Stringer](r)
+
+			registrationError := r.GET(test.Endpoint, func(name fmt.Stringer) string {
+				return name.String()
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, `"john"`, string(req.Response.Body.Bytes()))
+		},
+		"respond with 500 status code when middleware does not provide registered scope value": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			injection.RegisterScopeValue[*principal](r)
+
+			r.GET(test.Endpoint, func(user *principal) string {
+				return user.Name
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusInternalServerError, req.Response.Code)
+		},
+		"fail to register handler with value returned by middleware of other group": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.Group("/group", authenticate)
+
+			registrationError := r.GET(test.Endpoint, func(user *principal) {})
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+		"fail to register middleware returning several values": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.Use(func() (*principal, *credentials) { return nil, nil })
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_AbortingMiddleware(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"abort request handling when middleware returns false": func(t *testing.T) {
+			handlerCalled := false
+			r := setupRouterWithProviders()
+
+			registrationError := r.Use(func(c *gin.Context) bool {
+				c.Status(http.StatusUnauthorized)
+
+				return false
+			})
+			r.GET(test.Endpoint, func() {
+				handlerCalled = true
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusUnauthorized, req.Response.Code)
+			assert.False(t, handlerCalled)
+		},
+		"abort request handling and respond with error returned by middleware": func(t *testing.T) {
+			handlerCalled := false
+			r := setupRouterWithProviders()
+
+			registrationError := r.GET(test.Endpoint, func(c *gin.Context) error {
+				return injection.NewRequestError(http.StatusForbidden, errors.New("access denied"))
+			}, func() {
+				handlerCalled = true
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusForbidden, req.Response.Code)
+			assert.JSONEq(
+				t,
+				`{"type":"about:blank","title":"Forbidden","status":403,"detail":"access denied"}`,
+				string(req.Response.Body.Bytes()),
+			)
+			assert.False(t, handlerCalled)
+		},
+		"continue request handling with value returned by middleware returning nil error": func(t *testing.T) {
+			r := setupRouterWithProviders()
+			r.Use(func(c *gin.Context) (*principal, error) {
+				return &principal{Name: c.GetHeader("X-User")}, nil
+			})
+
+			registrationError := r.GET(test.Endpoint, func(user *principal) string {
+				return user.Name
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).
+				Header("X-User", "john").
+				MustBuild().Do(test.Router)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, `"john"`, string(req.Response.Body.Bytes()))
+		},
+		"abort request handling when middleware value resolution fails": func(t *testing.T) {
+			handlerCalled := false
+			r := setupRouterWithProviders()
+			r.RegisterProviders(func() *unavailableDependency {
+				panic("connection refused")
+			})
+
+			r.Use(func(dependency *unavailableDependency) {})
+			r.GET(test.Endpoint, func() {
+				handlerCalled = true
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusInternalServerError, req.Response.Code)
+			assert.False(t, handlerCalled)
+		},
+		"fail to register middleware with unsupported return values": func(t *testing.T) {
+			r := setupRouterWithProviders()
+
+			registrationError := r.Use(func() (error, bool) { return nil, true })
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"successfully get value resolved for request from request context": func(t *testing.T) {
+			var injected *test.DependencyStruct
+			var fromContext *test.DependencyStruct
+			var contextErr error
+			r := setupRouterWithProviders()
+
+			r.GET(test.Endpoint, func(c *gin.Context, dependency *test.DependencyStruct) {
+				injected = dependency
+				fromContext, contextErr = injection.FromContext[*test.DependencyStruct](c.Request.Context())
+			})
+
+			test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, contextErr)
+			assert.NotNil(t, injected)
+			assert.Same(t, injected, fromContext)
+		},
+		"successfully resolve value not resolved for request yet from request context": func(t *testing.T) {
+			var scope *injection.Scope
+			var dependency test.DependencyInterface
+			var contextErr error
+			r := setupRouterWithProviders()
+
+			r.GET(test.Endpoint, func(c *gin.Context) {
+				scope = injection.ScopeFromContext(c.Request.Context())
+				dependency, contextErr = injection.FromContext[test.DependencyInterface](c.Request.Context())
+			})
+
+			test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Nil(t, contextErr)
+			assert.NotNil(t, scope)
+			assert.IsType(t, &test.DependencyStruct{}, dependency)
+		},
+		"successfully get nil interface value provided into request scope from request context": func(t *testing.T) {
+			var name fmt.Stringer = &principalName{}
+			var contextErr error
+			r := setupRouterWithProviders()
+			injection.RegisterScopeValue[fmt.Stringer](r)
+
+			r.GET(test.Endpoint, func(c *gin.Context, scope *injection.Scope) {
+				injection.Provide[fmt.Stringer](scope, nil)
+				name, contextErr = injection.FromContext[fmt.Stringer](c.Request.Context())
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Nil(t, contextErr)
+			assert.Nil(t, name)
+		},
+		"share value resolved from request context by concurrent goroutines": func(t *testing.T) {
+			var values [8]test.DependencyInterface
+			r := setupRouterWithProviders()
+
+			r.GET(test.Endpoint, func(c *gin.Context) {
+				var wg sync.WaitGroup
+
+				for i := range values {
+					wg.Add(1)
+
+					go func(i int) {
+						defer wg.Done()
+
+						values[i], _ = injection.FromContext[test.DependencyInterface](c.Request.Context())
+					}(i)
+				}
+
+				wg.Wait()
+			})
+
+			test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			for _, value := range values {
+				assert.NotNil(t, value)
+				assert.Same(t, values[0], value)
+			}
+		},
+		"return error for value of unregistered type": func(t *testing.T) {
+			var contextErr error
+			r := setupRouterWithProviders()
+
+			r.GET(test.Endpoint, func(c *gin.Context) {
+				_, contextErr = injection.FromContext[*principal](c.Request.Context())
+			})
+
+			test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.IsType(t, injection.Error{}, contextErr)
+		},
+		"return error for value failing resolution": func(t *testing.T) {
+			var contextErr error
+			r := setupRouterWithProviders()
+			r.RegisterProviders(func() *unavailableDependency {
+				panic("connection refused")
+			})
+
+			r.GET(test.Endpoint, func(c *gin.Context) {
+				_, contextErr = injection.FromContext[*unavailableDependency](c.Request.Context())
+			})
+
+			test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.EqualError(t, contextErr, "resolving *gin.unavailableDependency for context: panic: connection refused")
+		},
+		"return error for context without request scope": func(t *testing.T) {
+			_, contextErr := injection.FromContext[*test.DependencyStruct](context.Background())
+
+			assert.Nil(t, injection.ScopeFromContext(context.Background()))
+			assert.IsType(t, injection.Error{}, contextErr)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+type wrappedDependency struct {
+	Path string
+}
+
+var wrappedDependencyCalls int
+
+var ginWrapperExecuted bool
+
+func provideWrappedDependency(ctx *gin.Context) *wrappedDependency {
+	wrappedDependencyCalls++
+
+	return &wrappedDependency{Path: ctx.FullPath()}
+}
+
+func wrappedDependencyHandler(ctx *gin.Context, dependency *wrappedDependency) {
+	constant, err := injection.FromContext[string](ctx.Request.Context())
+
+	if err != nil {
+		ctx.Status(http.StatusInternalServerError)
+		return
+	}
+
+	ctx.String(http.StatusOK, "%s %s", dependency.Path, constant)
+}
+
+func init() {
+	injection.RegisterWrapper(injection.Wrapper{
+		Handler:   wrappedDependencyHandler,
+		Providers: []injection.Provider{provideWrappedDependency},
+		Fn: func() func(*gin.Context) {
+			return func(ctx *gin.Context) {
+				ginWrapperExecuted = true
+				wrappedDependencyHandler(ctx, provideWrappedDependency(ctx))
+			}
+		},
+	})
+}
+
+func TestInjector_Wrapper(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"resolve value once per request for wrapped handler behind middleware": func(t *testing.T) {
+			ginWrapperExecuted, wrappedDependencyCalls = false, 0
+			r := setupRouterWithProviders()
+			r.RegisterProviders(provideWrappedDependency)
+			r.Use(func(dependency *wrappedDependency) {})
+			r.GET(test.Endpoint, wrappedDependencyHandler)
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.False(t, ginWrapperExecuted)
+			assert.Equal(t, 1, wrappedDependencyCalls)
+		},
+		"respond from wrapped handler same as from reflection based request handler": func(t *testing.T) {
+			ginWrapperExecuted = false
+			r := setupRouterWithProviders()
+			r.RegisterProviders(provideWrappedDependency)
+			r.GET("/wrapped", wrappedDependencyHandler)
+
+			wrappedReq := test.NewRequest("/wrapped", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.True(t, ginWrapperExecuted)
+
+			// route middleware keeps route handled by reflection based request handler
+			ginWrapperExecuted = false
+			r.GET("/reflected", func() {}, wrappedDependencyHandler)
+
+			reflectedReq := test.NewRequest("/reflected", http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.False(t, ginWrapperExecuted)
+			assert.Equal(t, http.StatusOK, wrappedReq.Response.Code)
+			assert.Equal(t, reflectedReq.Response.Code, wrappedReq.Response.Code)
+			assert.Equal(t, "/wrapped "+test.Constant, string(wrappedReq.Response.Body.Bytes()))
+			assert.Equal(t, "/reflected "+test.Constant, string(reflectedReq.Response.Body.Bytes()))
+		},
+		"run wrapped handler with request scope stored on request context": func(t *testing.T) {
+			ginWrapperExecuted, wrappedDependencyCalls = false, 0
+			r := setupRouterWithProviders()
+			r.RegisterProviders(provideWrappedDependency)
+			r.GET(test.Endpoint, wrappedDependencyHandler)
+
+			req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(test.Router)
+
+			assert.True(t, ginWrapperExecuted)
+			assert.Equal(t, 1, wrappedDependencyCalls)
+			assert.Equal(t, http.StatusOK, req.Response.Code)
+			assert.Equal(t, test.Endpoint+" "+test.Constant, string(req.Response.Body.Bytes()))
+		},
+	}
+
+	for testName, testCase := range testCases {
 		t.Run(testName, testCase)
 	}
 }
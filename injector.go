@@ -1,7 +1,9 @@
 package injection
 
 import (
+	"net/http"
 	"reflect"
+	"sync"
 )
 
 // Routes is used as integration layer between http library and Injector for usage see gin package
@@ -13,79 +15,242 @@ type Routes interface {
 	HandlerFnType() reflect.Type
 }
 
+// SeedRoutes is optional Routes capability for http libraries which request handler function(HandlerFnType)
+// has several input parameters or its input parameter does not implement context.Context interface
+type SeedRoutes interface {
+	// SeedTypes returns types of values seeded into every request resolution,
+	// at least one of the types should implement context.Context interface
+	SeedTypes() []reflect.Type
+
+	// SeedValues returns values of SeedTypes types from http library request handler function input values
+	SeedValues(args []reflect.Value) []reflect.Value
+}
+
+// GroupRoutes is optional Routes capability for http libraries supporting route groups
+type GroupRoutes interface {
+	// Group returns Routes registering routes under given path prefix with given middleware executed first,
+	// returns nil when underlying http library routes do not support groups
+	Group(prefix string, handlerFnValues ...reflect.Value) Routes
+}
+
+// ResponseRoutes is optional Routes capability for http libraries giving access to request http response,
+// required for rendering request handler return values
+type ResponseRoutes interface {
+	// ResponseWriter returns http response writer of the request from values seeded into request resolution
+	ResponseWriter(seeds []reflect.Value) http.ResponseWriter
+}
+
+// RouterRoutes is optional Routes capability for http libraries registering routes of several Routes values
+// into single router, such as router groups. Injectors created with From function for Routes of the same router
+// detect routes conflicting with routes registered by each other
+type RouterRoutes interface {
+	// Router returns comparable value identifying router the routes are registered into,
+	// along with path prefix of the routes within the router
+	Router() (router interface{}, prefix string)
+}
+
 // Injector acts as DI container, resolver and register for underlying Routes implementation
 type Injector struct {
-	routes      Routes
-	contextType reflect.Type
-	providers   map[reflect.Type]registeredProvider
+	routes                Routes
+	seedTypes             []reflect.Type
+	seedValues            func(args []reflect.Value) []reflect.Value
+	responseWriter        func(seeds []reflect.Value) http.ResponseWriter
+	scopes                ScopeRoutes
+	abort                 func(seeds []reflect.Value)
+	contextPlan           *resolutionPlan
+	contextMutex          sync.Mutex // guards compiling context plans of the Injector and all its groups
+	renderer              Renderer
+	validator             Validator
+	errorHandler          *errorHandler
+	providers             map[reflect.Type]*providerDefinition
+	routeDefinitions      []*routeDefinition
+	middlewareDefinitions []*handlerDefinition
+	parent                *Injector
+	prefix                string
+	groupMiddleware       []Handler
+	strictRoutes          bool
+	routeTable            *routeTable
+	routePrefix           string
 }
 
 // NewInjector crates new Injector instance,
 // returns error when given Routes implementation http request handler function(HandlerFnType):
-// - has more than one input parameter or the single parameter does not implement context.Context interface
+// - has more than one input parameter or the single parameter does not implement context.Context interface,
+// unless Routes implements SeedRoutes, then none of the SeedTypes implement context.Context interface
 func NewInjector(routes Routes) (*Injector, error) {
-	if err := validateRoutes(routes); err != nil {
-		return nil, err
+	return newInjector(routes, map[reflect.Type]*providerDefinition{})
+}
+
+// From creates new Injector from existing by copying over all registered value providers from given Injector,
+// except value providers of values seeded or bound from request by given Injector Routes
+func From(from *Injector, routes Routes) (*Injector, error) {
+	providers := map[reflect.Type]*providerDefinition{}
+
+	for providerType, provider := range from.allProviders() {
+		// values seeded, bound or provided by middleware of existing Injector Routes are not available with given Routes
+		if provider.lifetime != contextLifetime && provider.lifetime != bindingLifetime &&
+			provider.lifetime != scopeLifetime {
+			providers[providerType] = provider
+		}
 	}
 
-	injector := &Injector{
-		routes:    routes,
-		providers: map[reflect.Type]registeredProvider{},
+	injector, err := newInjector(routes, providers)
+
+	// injectors registering routes into same router detect routes conflicting with each other
+	if err == nil && injector.routeTable.router != nil && injector.routeTable.router == from.root().routeTable.router {
+		injector.routeTable = from.root().routeTable
 	}
-	injector.registerContextProvider()
 
-	return injector, nil
+	return injector, err
 }
 
-// From creates new Injector from existing by copying over all registered value providers from given Injector
-func From(from *Injector, routes Routes) (*Injector, error) {
+func newInjector(routes Routes, providers map[reflect.Type]*providerDefinition) (*Injector, error) {
 	if err := validateRoutes(routes); err != nil {
 		return nil, err
 	}
 
 	injector := &Injector{
-		routes:    routes,
-		providers: map[reflect.Type]registeredProvider{},
+		routes:     routes,
+		seedTypes:  []reflect.Type{routes.HandlerFnType().In(0)},
+		seedValues: func(args []reflect.Value) []reflect.Value { return args },
+		renderer:   jsonRenderer{},
+		validator:  ruleValidator{},
+		providers:  providers,
+		routeTable: &routeTable{},
 	}
-	injector.registerContextProvider()
 
-	for providerType, provider := range from.providers {
-		injector.providers[providerType] = provider
+	if routerRoutes, ok := routes.(RouterRoutes); ok {
+		injector.routeTable.router, injector.routePrefix = routerRoutes.Router()
 	}
 
+	if responseRoutes, ok := routes.(ResponseRoutes); ok {
+		injector.responseWriter = responseRoutes.ResponseWriter
+	}
+
+	if scopeRoutes, ok := routes.(ScopeRoutes); ok {
+		injector.scopes = scopeRoutes
+	}
+
+	if abortRoutes, ok := routes.(AbortRoutes); ok {
+		injector.abort = abortRoutes.Abort
+	}
+
+	if seedRoutes, ok := routes.(SeedRoutes); ok {
+		injector.seedTypes = seedRoutes.SeedTypes()
+		injector.seedValues = seedRoutes.SeedValues
+	}
+
+	injector.registerContextProviders()
+
 	return injector, nil
 }
 
-func (r *Injector) registerContextProvider() {
-	r.contextType = r.routes.HandlerFnType().In(0)
-	r.providers[r.contextType] = nil
+func (r *Injector) registerContextProviders() {
+	for _, seedType := range append([]reflect.Type{scopeType}, r.seedTypes...) {
+		r.providers[seedType] = newProviderDefinition(seedType, reflect.Value{}, contextLifetime)
+	}
 }
 
-func (r *Injector) registeredProviders(handler Provider) (handlerFuncProviders []*typedProvider) {
-	var handlerType reflect.Type
-	var firstFnParamIndex int
+// Group creates Injector registering routes under given path prefix with given middleware executed before route handlers.
+// Group inherits value providers registered with the Injector, value providers registered with the group
+// are only available for the group routes and override inherited providers of same type.
+// Panics when Injector Routes implementation does not support groups
+// or middleware function signature contains unregistered values
+func (r *Injector) Group(prefix string, middleware ...Handler) *Injector {
+	groupRoutes, ok := r.routes.(GroupRoutes)
 
-	if handlerMethod, ok := handler.(reflect.Method); ok {
-		handlerType = handlerMethod.Func.Type()
-		firstFnParamIndex = 1 // first param for method type is receiver, ignore it
-	} else {
-		handlerType = reflect.TypeOf(handler)
+	if !ok {
+		panic(newUnsupportedGroupRoutesError(r.routes))
+	}
+
+	group := &Injector{
+		routes:          r.routes,
+		seedTypes:       r.seedTypes,
+		seedValues:      r.seedValues,
+		responseWriter:  r.responseWriter,
+		scopes:          r.scopes,
+		abort:           r.abort,
+		providers:       map[reflect.Type]*providerDefinition{},
+		parent:          r,
+		prefix:          r.prefix + prefix,
+		groupMiddleware: append(append([]Handler{}, r.groupMiddleware...), middleware...),
+	}
+
+	// middleware is registered with the group, values it returns are only available for the group routes
+	registeredHandlers, err := group.registerHandlerFunctions(middleware, false, middlewareRoute)
+
+	if err != nil {
+		panic(err)
+	}
+
+	group.routes = groupRoutes.Group(prefix, registeredHandlers...)
+
+	if group.routes == nil {
+		panic(newUnsupportedGroupRoutesError(r.routes))
 	}
 
-	for ; firstFnParamIndex < handlerType.NumIn(); firstFnParamIndex++ {
-		dependencyType := handlerType.In(firstFnParamIndex)
+	return group
+}
+
+// root returns top level Injector of the group, root Injector holds route definitions of all its groups
+func (r *Injector) root() *Injector {
+	if r.parent == nil {
+		return r
+	}
 
-		handlerFuncProviders = append(
-			handlerFuncProviders,
-			newTypedProvider(dependencyType, r.registeredProvider(dependencyType)),
-		)
+	return r.parent.root()
+}
+
+// provider returns value provider registered with the Injector or closest parent Injector of the group
+func (r *Injector) provider(providerType reflect.Type) (*providerDefinition, bool) {
+	for injector := r; injector != nil; injector = injector.parent {
+		if provider, exists := injector.providers[providerType]; exists {
+			return provider, true
+		}
 	}
 
-	return
+	return nil, false
 }
 
-func (r *Injector) registeredProvider(providerType reflect.Type) registeredProvider {
-	if provider, exists := r.providers[providerType]; exists {
+// allProviders returns value providers available for the Injector routes, including inherited providers of the group
+func (r *Injector) allProviders() map[reflect.Type]*providerDefinition {
+	providers := map[reflect.Type]*providerDefinition{}
+
+	if r.parent != nil {
+		providers = r.parent.allProviders()
+	}
+
+	for providerType, provider := range r.providers {
+		providers[providerType] = provider
+	}
+
+	return providers
+}
+
+func (r *Injector) registeredProvider(providerType reflect.Type) *providerDefinition {
+	if provider, exists := r.provider(providerType); exists {
+		return provider
+	}
+
+	var provider *providerDefinition
+
+	// types bound from request have value providers registered on first use
+	switch {
+	case providerType.Kind() != reflect.Ptr &&
+		(isFormRequest(providerType) || isBindable(providerType) || isParams(providerType)):
+		provider = dereferencedProvider(providerType)
+	case isFormRequest(providerType):
+		provider = r.formRequestProvider(providerType)
+	case isBindable(providerType):
+		provider = r.boundProvider(providerType, nil, r.bodyBinder(providerType))
+	case isParams(providerType):
+		provider = r.boundProvider(providerType, nil, r.paramsBinder(providerType))
+	}
+
+	// bound values are registered with root Injector, so that request is bound once for all groups
+	if provider != nil {
+		r.root().addProvider(provider)
+
 		return provider
 	}
 
@@ -93,13 +258,14 @@ func (r *Injector) registeredProvider(providerType reflect.Type) registeredProvi
 }
 
 func (r *Injector) registerProvider(provider Provider) bool {
-	var dependencyProviders []*typedProvider
 	var providerValue reflect.Value
 	var providerType reflect.Type
+	lifetime := requestLifetime
 
 	if singletonProvider, ok := provider.(*singletonProvider); ok {
 		providerValue = funcValueOf(singletonProvider.provider)
 		providerType = providerValue.Type()
+		lifetime = singletonLifetime
 	} else {
 		providerValue = funcValueOf(provider)
 		providerType = providerValue.Type()
@@ -110,41 +276,22 @@ func (r *Injector) registerProvider(provider Provider) bool {
 	}
 
 	for i := 0; i < providerType.NumIn(); i++ {
-		providerType := providerType.In(i)
-
-		if provider, exists := r.providers[providerType]; exists {
-			dependencyProviders = append(dependencyProviders, newTypedProvider(providerType, provider))
-
-			continue
+		if _, exists := r.provider(providerType.In(i)); !exists {
+			return false
 		}
-
-		return false
 	}
 
 	providedValueType := providerType.Out(0)
 
-	r.providers[providedValueType] = func(resolvedValues resolvedValues) interface{} {
-		dependencyProviders = r.refreshedDependencyProviders(dependencyProviders)
-
-		callResults := providerValue.Call(resolveProviders(dependencyProviders, resolvedValues))
-		resolvedValue := callResults[0].Interface()
-
-		if _, ok := provider.(*singletonProvider); ok {
-			r.providers[providedValueType] = registeredSingletonProvider(resolvedValue)
-		}
-
-		return resolvedValue
-	}
+	r.addProvider(newProviderDefinition(providedValueType, providerValue, lifetime))
 
 	return true
 }
 
-func (r *Injector) refreshedDependencyProviders(dependencyProviders []*typedProvider) []*typedProvider {
-	for _, provider := range dependencyProviders {
-		provider.value = r.providers[provider.kind]
-	}
-
-	return dependencyProviders
+// addProvider registers value provider into the Injector, replacing provider of the same type registered before.
+// Providers of all kinds are registered here, so that groups inherit them and Injector manifest describes them
+func (r *Injector) addProvider(definition *providerDefinition) {
+	r.providers[definition.kind] = definition
 }
 
 // RegisterProviders registers value provider functions into DI container.
@@ -188,40 +335,21 @@ func (r *Injector) RegisterProviders(providers ...Provider) (err error) {
 	return newCannotRegisterProvidersError(unRegistered)
 }
 
-func (r *Injector) controllerProviders(ctrlVal reflect.Value) (ctrlFieldProviders []*typedProvider) {
-
-	if ctrlVal.Kind() == reflect.Ptr {
-		ctrlVal = ctrlVal.Elem()
-	} else {
-		ctrlVal = addressableCpy(ctrlVal)
-	}
-
-	for i := 0; i < ctrlVal.NumField(); i++ {
-		fieldVal := unsafeFieldElem(ctrlVal, i)
-		fieldType := fieldVal.Type()
-
-		if isNilValue(fieldVal) {
-			ctrlFieldProviders = append(
-				ctrlFieldProviders,
-				newTypedProvider(fieldType, r.registeredProvider(fieldType)),
-			)
-
-			continue
-		}
-
-		ctrlFieldProviders = append(
-			ctrlFieldProviders,
-			newTypedProvider(fieldType, staticValueRegisterProvider(fieldVal)),
-		)
-	}
-
-	return
+// StrictRoutes enables or disables strict Controller routes registration for the Injector and all its groups.
+// In strict mode Controller registration fails when route http method is neither given explicitly with route spec
+// nor inferable from request handler method name, instead of falling back to GET
+func (r *Injector) StrictRoutes(strict bool) {
+	r.root().strictRoutes = strict
 }
 
 // RegisterController enables given Controller implementation to have field values and http request handler function input values
-// injected from registered value providers,
+// injected from registered value providers.
+// Controller routes are registered from Controller Routes method result along with given routes declared with
+// Controller method expressions, example:
+// injector.RegisterController(ctrl, injection.Route(http.MethodPost, "/users", (*UserController).PostCreate))
 // returns error when given Controller Routes method result contains unknown Controller method
-func (r *Injector) RegisterController(controller Controller) (err error) {
+// or route method expression does not belong to given Controller
+func (r *Injector) RegisterController(controller Controller, routes ...*ControllerRoute) (err error) {
 	defer func() {
 		e := recover()
 
@@ -235,33 +363,60 @@ func (r *Injector) RegisterController(controller Controller) (err error) {
 		}
 	}()
 
+	instances := newControllerInstances(requestLifetime)
+
+	switch lifetimeController := controller.(type) {
+	case *pooledController:
+		controller = lifetimeController.Controller
+		instances = newControllerInstances(pooledLifetime)
+	case *singletonController:
+		controller = lifetimeController.Controller
+		instances = newControllerInstances(singletonLifetime)
+	}
+
 	ctrlVal := reflect.ValueOf(controller)
 	ctrlType := ctrlVal.Type()
 
-	ctrlFieldProviders := r.controllerProviders(ctrlVal)
+	controllerRoutes := routesList(controller, r.root().strictRoutes)
+	controllerRoutes = append(controllerRoutes, methodExprRoutesList(controller, routes)...)
+	applyControllerRouteDefaults(controller, controllerRoutes)
+
+	var routeHandlers [][]reflect.Value
+	var definitions []*routeDefinition
 
-	for _, controllerRoute := range routesList(controller) {
+	// all Controller routes are resolved before registering any, so that failing Controller registers no routes
+	for _, controllerRoute := range controllerRoutes {
 		if validationErr := validateControllerMethod(controllerRoute.methodName, ctrlVal); validationErr != nil {
 			panic(validationErr)
 		}
 
-		httpMethod := handlerHTTPMethod(controllerRoute.methodName)
-
-		routeHandlers := r.routeMiddlewareHandlers(controller.Middleware()[controllerRoute.methodName])
-		routeHandlers = append(routeHandlers, r.controllerHandler(ctrlType, controllerRoute.methodName, ctrlFieldProviders))
+		handlerMethod, _ := ctrlType.MethodByName(controllerRoute.methodName)
+		route := routeName([]string{controllerRoute.httpMethod}, r.prefix+controllerRoute.route)
 
-		r.routes = r.routes.Handle(
-			httpMethod,
+		handlers := r.routeMiddlewareHandlers(controllerRoute.middleware, route)
+		handler := r.controllerHandler(ctrlVal, handlerMethod, instances, r.wrappable(controllerRoute.middleware), route)
+		routeHandlers = append(routeHandlers, append(handlers, handler))
+		definitions = append(definitions, r.newRouteDefinition(
+			controllerRoute.httpMethod,
 			controllerRoute.route,
-			routeHandlers...,
-		)
+			controllerRoute.middleware,
+			newControllerHandlerDefinition(ctrlVal, handlerMethod),
+		))
+	}
+
+	if reservationErr := r.reserveRoutes(definitions); reservationErr != nil {
+		panic(reservationErr)
+	}
+
+	for i, controllerRoute := range controllerRoutes {
+		r.routes = r.routes.Handle(controllerRoute.httpMethod, controllerRoute.route, routeHandlers[i]...)
 	}
 
 	return err
 }
 
-func (r *Injector) routeMiddlewareHandlers(handlers []Handler) []reflect.Value {
-	registeredHandlers, err := r.registerHandlerFunctions(handlers)
+func (r *Injector) routeMiddlewareHandlers(handlers []Handler, route string) []reflect.Value {
+	registeredHandlers, err := r.registerHandlerFunctions(handlers, false, route)
 
 	if err != nil {
 		panic(err)
@@ -270,32 +425,51 @@ func (r *Injector) routeMiddlewareHandlers(handlers []Handler) []reflect.Value {
 	return registeredHandlers
 }
 
-func (r *Injector) controllerHandler(ctrlType reflect.Type, handlerMethodName string, ctrlFieldProviders []*typedProvider) reflect.Value {
-	handlerMethodType, _ := ctrlType.MethodByName(handlerMethodName)
-	handlerMethodProviders := r.registeredProviders(handlerMethodType)
+func (r *Injector) controllerHandler(
+	ctrlVal reflect.Value,
+	handlerMethod reflect.Method,
+	instances *controllerInstances,
+	wrappable bool,
+	route string,
+) reflect.Value {
+	plan := r.compileControllerPlan(ctrlVal, handlerMethod, instances, route)
+	resultsPlan := r.compileResults(handlerMethod.Type, route)
+
+	// generated wrappers create new Controller instance for every request, do not call action hooks nor render results
+	if wrappable && instances.lifetime == requestLifetime && plan.beforeAction == nil && plan.afterAction == nil &&
+		resultsPlan == nil {
+		if wrappedHandler, ok := r.wrappedControllerHandler(ctrlVal, handlerMethod, route); ok {
+			return wrappedHandler
+		}
+	}
 
 	return reflect.MakeFunc(r.routes.HandlerFnType(), func(args []reflect.Value) (results []reflect.Value) {
-		resolvedValues := r.resolvedCtxValues(args[0])
-
-		// resolveProviders fn adds any resolved value into resolvedValues variable
-		methodProvidersValues := resolveProviders(
-			handlerMethodProviders,
-			resolvedValues,
-		)
-
-		if isPtrType(ctrlType) {
-			resolvedCtrl := resolveController(ctrlType.Elem(), ctrlFieldProviders, resolvedValues)
-			resolvedCtrl.MethodByName(handlerMethodName).Call(methodProvidersValues)
-		} else {
-			resolvedCtrl := resolveController(ctrlType, ctrlFieldProviders, resolvedValues)
-			resolvedCtrl.Elem().MethodByName(handlerMethodName).Call(methodProvidersValues)
+		seeds := r.seedValues(args)
+		defer r.recoverRequestFailure(seeds, route)
+
+		handlerResults, err := plan.call(seeds)
+
+		if err != nil {
+			if r.abort != nil {
+				r.abort(seeds)
+			}
+
+			r.handleError(seeds, &StageError{Stage: "handling " + route, Err: err})
+		} else if resultsPlan != nil && handlerResults != nil {
+			resultsPlan.render(r, seeds, handlerResults)
 		}
 
 		return
 	})
 }
 
-func (r *Injector) registerHandlerFunctions(handlers []Handler) (registeredHandlers []reflect.Value, err error) {
+// registerHandlerFunctions registers given request handler functions of given route,
+// return values of the last handler function are rendered into http response when renderLast is set
+func (r *Injector) registerHandlerFunctions(
+	handlers []Handler,
+	renderLast bool,
+	route string,
+) (registeredHandlers []reflect.Value, err error) {
 	defer func() {
 		e := recover()
 
@@ -309,53 +483,205 @@ func (r *Injector) registerHandlerFunctions(handlers []Handler) (registeredHandl
 		}
 	}()
 
-	for _, handlerFunc := range handlers {
-		registeredHandlers = append(registeredHandlers, r.routeHandler(handlerFunc))
+	wrappable := renderLast && len(handlers) > 0 && r.wrappable(handlers[:len(handlers)-1])
+
+	for i, handlerFunc := range handlers {
+		rendered := renderLast && i == len(handlers)-1
+		registeredHandlers = append(registeredHandlers, r.routeHandler(handlerFunc, rendered, rendered && wrappable, route))
 	}
 
 	return registeredHandlers, err
 }
 
-func (r *Injector) routeHandler(handlerFunc Handler) reflect.Value {
+// wrappable reports whether generated Wrapper can handle route with given route middleware. Wrapper calls value
+// providers directly, so it is not used for routes with middleware, which would resolve values the Wrapper resolves again
+func (r *Injector) wrappable(routeMiddleware []Handler) bool {
+	return len(routeMiddleware) == 0 && len(r.groupMiddleware) == 0 && len(r.root().middlewareDefinitions) == 0
+}
+
+func (r *Injector) routeHandler(handlerFunc Handler, rendered bool, wrappable bool, route string) reflect.Value {
 	handlerFuncValue := funcValueOf(handlerFunc)
-	handlerFuncProviders := r.registeredProviders(handlerFunc)
+	plan := r.compilePlan(fnParamTypes(handlerFuncValue.Type(), 0), route)
+
+	var resultsPlan *resultsPlan
+	var middlewareResults *middlewareResults
+
+	if rendered {
+		resultsPlan = r.compileResults(handlerFuncValue.Type(), route)
+	} else {
+		middlewareResults = r.compileMiddlewareResults(handlerFuncValue.Type())
+	}
+
+	// generated wrappers do not handle return values
+	if wrappable && resultsPlan == nil {
+		if wrappedHandler, ok := r.wrappedHandler(handlerFuncValue, route); ok {
+			return wrappedHandler
+		}
+	}
 
 	return reflect.MakeFunc(r.routes.HandlerFnType(), func(args []reflect.Value) (results []reflect.Value) {
-		providersValues := resolveProviders(
-			handlerFuncProviders,
-			r.resolvedCtxValues(args[0]),
-		)
+		seeds := r.seedValues(args)
+		defer r.recoverRequestFailure(seeds, route)
+
+		scope := plan.scope(seeds)
+		handlerResults := handlerFuncValue.Call(plan.arguments(scope, 0))
+
+		if resultsPlan != nil {
+			resultsPlan.render(r, seeds, handlerResults)
+		}
+
+		if middlewareResults == nil {
+			return
+		}
 
-		handlerFuncValue.Call(providersValues)
+		if proceed, err := middlewareResults.apply(scope, handlerResults); !proceed {
+			r.abort(seeds)
+
+			if err != nil {
+				r.handleError(seeds, &StageError{Stage: "handling " + route, Err: err})
+			}
+		}
 
 		return
 	})
 }
 
-func (r *Injector) resolvedCtxValues(ctxVal reflect.Value) resolvedValues {
-	return map[reflect.Type]reflect.Value{r.contextType: ctxVal}
-}
-
-// Use registers http middleware handlers, returns error when handler function signature contains unregistered values
+// Use registers http middleware handlers, middleware can return T, error, bool or (T, error) values:
+// returned T value is stored into request scope for the rest of request handlers, returned non nil error
+// or false aborts request handling. Returns error when handler function signature contains unregistered values
 func (r *Injector) Use(handlers ...Handler) error {
-	registeredHandlers, err := r.registerHandlerFunctions(handlers)
+	registeredHandlers, err := r.registerHandlerFunctions(handlers, false, middlewareRoute)
 
-	if err == nil {
-		r.routes = r.routes.Use(registeredHandlers...)
+	if err != nil {
+		return err
 	}
 
-	return err
+	r.routes = r.routes.Use(registeredHandlers...)
+
+	// group middleware is described along with every route of the group
+	if r.parent != nil {
+		r.groupMiddleware = append(r.groupMiddleware, handlers...)
+
+		return nil
+	}
+
+	for _, handler := range handlers {
+		r.middlewareDefinitions = append(r.middlewareDefinitions, newHandlerDefinition(handler))
+	}
+
+	return nil
 }
 
 // Handle registers a new request handle and middleware with the given path and method.
 // The last handler should be the real handler, the other ones should be middleware that can and should be shared among different routes.
+// Return values of the last handler are rendered into http response with Injector Renderer,
+// supported return values are T, error, (T, error) and (int, T), where int is http response status code.
 // Returns error when handler function signature contains unregistered values
+// or route with same http method and path is already registered with the Injector
+// or another Injector registering routes into same router
 func (r *Injector) Handle(httpMethod string, endPoint string, handlers ...Handler) error {
-	registeredHandlers, err := r.registerHandlerFunctions(handlers)
+	return r.handle([]string{httpMethod}, endPoint, handlers)
+}
+
+// GET is a shortcut for Handle(http.MethodGet, endPoint, handlers...)
+func (r *Injector) GET(endPoint string, handlers ...Handler) error {
+	return r.Handle(http.MethodGet, endPoint, handlers...)
+}
+
+// POST is a shortcut for Handle(http.MethodPost, endPoint, handlers...)
+func (r *Injector) POST(endPoint string, handlers ...Handler) error {
+	return r.Handle(http.MethodPost, endPoint, handlers...)
+}
+
+// PUT is a shortcut for Handle(http.MethodPut, endPoint, handlers...)
+func (r *Injector) PUT(endPoint string, handlers ...Handler) error {
+	return r.Handle(http.MethodPut, endPoint, handlers...)
+}
+
+// PATCH is a shortcut for Handle(http.MethodPatch, endPoint, handlers...)
+func (r *Injector) PATCH(endPoint string, handlers ...Handler) error {
+	return r.Handle(http.MethodPatch, endPoint, handlers...)
+}
+
+// DELETE is a shortcut for Handle(http.MethodDelete, endPoint, handlers...)
+func (r *Injector) DELETE(endPoint string, handlers ...Handler) error {
+	return r.Handle(http.MethodDelete, endPoint, handlers...)
+}
+
+// HEAD is a shortcut for Handle(http.MethodHead, endPoint, handlers...)
+func (r *Injector) HEAD(endPoint string, handlers ...Handler) error {
+	return r.Handle(http.MethodHead, endPoint, handlers...)
+}
+
+// OPTIONS is a shortcut for Handle(http.MethodOptions, endPoint, handlers...)
+func (r *Injector) OPTIONS(endPoint string, handlers ...Handler) error {
+	return r.Handle(http.MethodOptions, endPoint, handlers...)
+}
+
+// Any registers a new request handle and middleware with the given path for every http method,
+// handler functions are resolved once and shared by all registered routes
+func (r *Injector) Any(endPoint string, handlers ...Handler) error {
+	return r.handle(httpMethods, endPoint, handlers)
+}
+
+func (r *Injector) handle(httpMethods []string, endPoint string, handlers []Handler) error {
+	registeredHandlers, err := r.registerHandlerFunctions(handlers, true, routeName(httpMethods, r.prefix+endPoint))
+
+	if err != nil {
+		return err
+	}
 
-	if err == nil {
+	var definitions []*routeDefinition
+
+	for _, httpMethod := range httpMethods {
+		definitions = append(definitions, r.newRouteDefinition(httpMethod, endPoint, handlers))
+	}
+
+	if err := r.reserveRoutes(definitions); err != nil {
+		return err
+	}
+
+	for _, httpMethod := range httpMethods {
 		r.routes = r.routes.Handle(httpMethod, endPoint, registeredHandlers...)
 	}
 
-	return err
+	return nil
+}
+
+func (r *Injector) newRouteDefinition(
+	httpMethod string,
+	endPoint string,
+	handlers []Handler,
+	ctrlHandlers ...*handlerDefinition,
+) *routeDefinition {
+	definition := &routeDefinition{httpMethod: httpMethod, endPoint: r.prefix + endPoint}
+
+	for _, handler := range append(append([]Handler{}, r.groupMiddleware...), handlers...) {
+		definition.handlers = append(definition.handlers, newHandlerDefinition(handler))
+	}
+
+	definition.handlers = append(definition.handlers, ctrlHandlers...)
+
+	return definition
+}
+
+// reserveRoutes records given routes with root Injector of the group,
+// returns error naming both conflicting routes sources when any of given routes conflicts with route
+// registered by the Injector or another Injector registering routes into same router,
+// none of given routes are recorded in that case
+func (r *Injector) reserveRoutes(definitions []*routeDefinition) error {
+	root := r.root()
+	var routes []*reservedRoute
+
+	for _, definition := range definitions {
+		routes = append(routes, &reservedRoute{definition: definition, prefix: root.routePrefix})
+	}
+
+	if err := root.routeTable.reserve(routes); err != nil {
+		return err
+	}
+
+	root.routeDefinitions = append(root.routeDefinitions, definitions...)
+
+	return nil
 }
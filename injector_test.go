@@ -68,6 +68,45 @@ func (*nonContextHandlerRoutes) HandlerFnType() reflect.Type {
 	return reflect.TypeOf(func(interface{}) {})
 }
 
+type seedRequest struct {
+	ctx  context.Context
+	name string
+}
+
+// seedRoutes request handler receives several input values, none of them implementing context.Context interface
+type seedRoutes struct {
+	testRoutes
+	handlers []reflect.Value
+}
+
+func (r *seedRoutes) Handle(httpMethod string, endPoint string, handlerFnValues ...reflect.Value) Routes {
+	r.handlers = append(r.handlers, handlerFnValues...)
+
+	return r
+}
+
+func (*seedRoutes) HandlerFnType() reflect.Type {
+	return reflect.TypeOf(func(*seedRequest, int) {})
+}
+
+func (*seedRoutes) SeedTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeOf(new(context.Context)).Elem(), reflect.TypeOf(""), reflect.TypeOf(0)}
+}
+
+func (*seedRoutes) SeedValues(args []reflect.Value) []reflect.Value {
+	request := args[0].Interface().(*seedRequest)
+
+	return []reflect.Value{reflect.ValueOf(&request.ctx).Elem(), reflect.ValueOf(request.name), args[1]}
+}
+
+type nonContextSeedRoutes struct {
+	seedRoutes
+}
+
+func (*nonContextSeedRoutes) SeedTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeOf(""), reflect.TypeOf(0)}
+}
+
 type PointerController struct {
 	BaseController
 
@@ -223,6 +262,27 @@ func TestInjector_Handle(t *testing.T) {
 
 			assert.Nil(t, handleRegisterErr)
 		},
+		"fail to register handler with return values when Routes do not implement ResponseRoutes": func(t *testing.T) {
+			injector := setupInjector(t)
+
+			handleRegisterErr := injector.Handle(http.MethodGet, test.Endpoint, func() string { return test.Response })
+
+			assert.IsType(t, Error{}, handleRegisterErr)
+		},
+		"fail to register handler with bound values when Routes do not implement BindingRoutes": func(t *testing.T) {
+			injector := setupInjector(t)
+
+			handleRegisterErr := injector.Handle(http.MethodGet, test.Endpoint, func(request *struct{ Body }) {})
+
+			assert.IsType(t, Error{}, handleRegisterErr)
+		},
+		"fail to register handler with Params values when Routes do not implement ParamRoutes": func(t *testing.T) {
+			injector := setupInjector(t)
+
+			handleRegisterErr := injector.Handle(http.MethodGet, test.Endpoint, func(params *struct{ Params }) {})
+
+			assert.IsType(t, Error{}, handleRegisterErr)
+		},
 		"resolve singletonProvider only once": func(t *testing.T) {
 			var resolvedValues []*test.DependencyStruct
 
@@ -230,8 +290,10 @@ func TestInjector_Handle(t *testing.T) {
 			injector, _ := NewInjector(&testRoutes{t: t})
 			err := injector.RegisterProviders(NewSingletonProvider(provider))
 
+			// same route can be registered only once per Injector, Injector copies share singleton providers
 			for i := 0; i < 2; i++ {
-				injector.Handle(http.MethodGet, test.Endpoint, func(singletonVal *test.DependencyStruct) {
+				injectorCpy, _ := From(injector, &testRoutes{t: t})
+				injectorCpy.Handle(http.MethodGet, test.Endpoint, func(singletonVal *test.DependencyStruct) {
 					resolvedValues = append(resolvedValues, singletonVal)
 				})
 			}
@@ -254,13 +316,45 @@ func TestInjector_Handle(t *testing.T) {
 			injector, _ := NewInjector(&testRoutes{t: t})
 			err := injector.RegisterProviders(NewSingletonProvider(provider), provider2)
 
+			// same route can be registered only once per Injector, Injector copies share singleton providers
 			for i := 0; i < 2; i++ {
-				injector.Handle(http.MethodGet, test.Endpoint, func(s test.DependencyInterface) {})
+				injectorCpy, _ := From(injector, &testRoutes{t: t})
+				injectorCpy.Handle(http.MethodGet, test.Endpoint, func(s test.DependencyInterface) {})
 			}
 
 			assert.Nil(t, err)
 			assert.Equal(t, 1, providerExecutedTimes)
 		},
+		"retry singletonProvider on next request when it panics": func(t *testing.T) {
+			var providerExecutedTimes int
+			var resolvedValues []*test.DependencyStruct
+
+			provider := func() *test.DependencyStruct {
+				providerExecutedTimes++
+
+				if providerExecutedTimes == 1 {
+					panic("temporarily unavailable")
+				}
+
+				return &test.DependencyStruct{}
+			}
+
+			injector, _ := NewInjector(&testRoutes{t: t})
+			err := injector.RegisterProviders(NewSingletonProvider(provider))
+
+			// same route can be registered only once per Injector, Injector copies share singleton providers
+			for i := 0; i < 3; i++ {
+				injectorCpy, _ := From(injector, &testRoutes{t: t})
+				injectorCpy.Handle(http.MethodGet, test.Endpoint, func(singletonVal *test.DependencyStruct) {
+					resolvedValues = append(resolvedValues, singletonVal)
+				})
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, 2, providerExecutedTimes)
+			assert.Len(t, resolvedValues, 2)
+			assert.True(t, resolvedValues[0] == resolvedValues[1], "both values in resolvedValues should be same instance")
+		},
 		"resolve new instance with non singletonProvider": func(t *testing.T) {
 			var resolvedValues []*test.DependencyStruct
 
@@ -268,8 +362,10 @@ func TestInjector_Handle(t *testing.T) {
 			injector, _ := NewInjector(&testRoutes{t: t})
 			err := injector.RegisterProviders(provider)
 
+			// same route can be registered only once per Injector, Injector copies share singleton providers
 			for i := 0; i < 2; i++ {
-				injector.Handle(http.MethodGet, test.Endpoint, func(singletonVal *test.DependencyStruct) {
+				injectorCpy, _ := From(injector, &testRoutes{t: t})
+				injectorCpy.Handle(http.MethodGet, test.Endpoint, func(singletonVal *test.DependencyStruct) {
 					resolvedValues = append(resolvedValues, singletonVal)
 				})
 			}
@@ -284,6 +380,16 @@ func TestInjector_Handle(t *testing.T) {
 
 			registrationError := injector.Handle(http.MethodGet, test.Endpoint, testHandlerFn)
 
+			assert.IsType(t, Error{}, registrationError)
+		},
+		"fail to register handler with cyclic provider dependencies": func(t *testing.T) {
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(func() string { return test.Constant })
+			injector.RegisterProviders(func(constant string) *test.DependencyStruct { return &test.DependencyStruct{} })
+			injector.RegisterProviders(func(dependency *test.DependencyStruct) string { return test.Constant })
+
+			registrationError := injector.Handle(http.MethodGet, test.Endpoint, func(constant string) {})
+
 			assert.IsType(t, Error{}, registrationError)
 		},
 	}
@@ -310,6 +416,75 @@ func TestInjector_Use(t *testing.T) {
 
 			assert.IsType(t, Error{}, registrationError)
 		},
+		"fail to register middleware returning value when Routes do not implement ScopeRoutes": func(t *testing.T) {
+			injector := setupInjector(t)
+
+			registrationError := injector.Use(func() *http.Client { return http.DefaultClient })
+
+			assert.IsType(t, Error{}, registrationError)
+		},
+		"fail to register middleware returning error when Routes do not implement AbortRoutes": func(t *testing.T) {
+			injector := setupInjector(t)
+
+			registrationError := injector.Use(func() error { return nil })
+
+			assert.IsType(t, Error{}, registrationError)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+type groupRoutes struct {
+	benchmarkRoutes
+}
+
+func (r *groupRoutes) Use(handlerFnValues ...reflect.Value) Routes {
+	return r
+}
+
+func (r *groupRoutes) Handle(httpMethod string, endPoint string, handlerFnValues ...reflect.Value) Routes {
+	r.benchmarkRoutes.Handle(httpMethod, endPoint, handlerFnValues...)
+
+	return r
+}
+
+func (r *groupRoutes) Group(prefix string, handlerFnValues ...reflect.Value) Routes {
+	r.handlers = append(r.handlers, handlerFnValues...)
+
+	return r
+}
+
+func TestInjector_Group(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"should describe group routes with prefix and group middleware": func(t *testing.T) {
+			var document manifest
+
+			injector, _ := NewInjector(&groupRoutes{})
+			group := injector.Group("/api").Group("/v1", func(ctx context.Context) {})
+
+			group.RegisterProviders(func() string { return test.Constant })
+			registrationErr := group.Handle(http.MethodGet, test.Endpoint, func(providedConstant string) {})
+
+			manifestJSON, _ := injector.Manifest()
+			test.MustUnMarshal(manifestJSON, &document)
+
+			assert.Nil(t, registrationErr)
+			assert.Len(t, document.Routes, 1)
+			assert.Equal(t, "/api/v1"+test.Endpoint, document.Routes[0].Path)
+			assert.Len(t, document.Routes[0].Handlers, 2)
+			assert.Equal(t, []string{"context.Context"}, document.Routes[0].Handlers[0].Parameters)
+
+			// value providers registered with group are not available for parent Injector
+			assert.NotNil(t, injector.Handle(http.MethodGet, test.Endpoint, func(providedConstant string) {}))
+		},
+		"should panic when Routes do not support groups": func(t *testing.T) {
+			injector, _ := NewInjector(&testRoutes{t: t})
+
+			assert.Panics(t, func() { injector.Group("/v1") })
+		},
 	}
 
 	for testName, testCase := range testCases {
@@ -369,6 +544,272 @@ func TestInjector_RegisterController(t *testing.T) {
 	}
 }
 
+type lifetimeController struct {
+	BaseController
+
+	Dependency *test.DependencyStruct
+
+	Constant string
+
+	handled *[]*lifetimeController
+}
+
+func (c *lifetimeController) Routes() map[string][]string {
+	return map[string][]string{test.Endpoint: {"GetTest"}}
+}
+
+func (c *lifetimeController) GetTest(ctx context.Context) {
+	*c.handled = append(*c.handled, c)
+}
+
+type hookedLifetimeController struct {
+	lifetimeController
+}
+
+func (c *hookedLifetimeController) BeforeAction() bool {
+	return true
+}
+
+type statefulController struct {
+	BaseController
+
+	Constant string
+
+	requestCount int
+
+	counts *[]int
+}
+
+func (c *statefulController) Routes() map[string][]string {
+	return map[string][]string{test.Endpoint: {"GetTest"}}
+}
+
+func (c *statefulController) GetTest(ctx context.Context) {
+	c.requestCount++
+	*c.counts = append(*c.counts, c.requestCount)
+}
+
+type sortedRoutesController struct {
+	BaseController
+}
+
+func (c *sortedRoutesController) Routes() map[string][]string {
+	return map[string][]string{
+		"/c":        {"GetTest"},
+		"/a":        {"GetTest", "PostTest"},
+		"DELETE /b": {"GetTest"},
+	}
+}
+
+func (c *sortedRoutesController) GetTest() {}
+
+func (c *sortedRoutesController) PostTest() {}
+
+type recordingRoutes struct {
+	benchmarkRoutes
+	routes []string
+}
+
+func (r *recordingRoutes) Handle(httpMethod string, endPoint string, handlerFnValues ...reflect.Value) Routes {
+	r.routes = append(r.routes, httpMethod+" "+endPoint)
+
+	return r
+}
+
+type routerRoutes struct {
+	recordingRoutes
+	router *recordingRoutes
+	prefix string
+}
+
+func (r *routerRoutes) Router() (interface{}, string) {
+	return r.router, r.prefix
+}
+
+func TestInjector_RouteRegistration(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"should register Controller routes in sorted order": func(t *testing.T) {
+			routes := &recordingRoutes{}
+			injector, _ := NewInjector(routes)
+
+			registrationErr := injector.RegisterController(new(sortedRoutesController))
+
+			assert.Nil(t, registrationErr)
+			assert.Equal(t, []string{"GET /a", "POST /a", "DELETE /b", "GET /c"}, routes.routes)
+		},
+		"fail registering already registered route": func(t *testing.T) {
+			routes := &recordingRoutes{}
+			injector, _ := NewInjector(routes)
+
+			firstErr := injector.Handle(http.MethodGet, "/users/:id", testHandlerFn)
+			conflictErr := injector.Handle(http.MethodGet, "/users/:name", testHandlerFn)
+
+			assert.Nil(t, firstErr)
+			assert.IsType(t, Error{}, conflictErr)
+			assert.Contains(t, conflictErr.Error(), "GET /users/:id handled by github.com/surmus/injection.testHandlerFn")
+			assert.Contains(t, conflictErr.Error(), "GET /users/:name handled by github.com/surmus/injection.testHandlerFn")
+			assert.Equal(t, []string{"GET /users/:id"}, routes.routes)
+		},
+		"fail registering route naming wildcard of registered route differently": func(t *testing.T) {
+			routes := &recordingRoutes{}
+			injector, _ := NewInjector(routes)
+
+			firstErr := injector.Handle(http.MethodGet, "/users/:id", testHandlerFn)
+			conflictErr := injector.Handle(http.MethodGet, "/users/:name/posts", testHandlerFn)
+
+			assert.Nil(t, firstErr)
+			assert.IsType(t, Error{}, conflictErr)
+			assert.Contains(t, conflictErr.Error(), "GET /users/:name/posts handled by")
+			assert.Nil(t, injector.Handle(http.MethodGet, "/users/:id/posts", testHandlerFn))
+			assert.Nil(t, injector.Handle(http.MethodPost, "/users/:name/posts", testHandlerFn))
+			assert.Equal(t, []string{"GET /users/:id", "GET /users/:id/posts", "POST /users/:name/posts"}, routes.routes)
+		},
+		"fail registering route conflicting with route of Injector registering routes into same router": func(t *testing.T) {
+			router := &recordingRoutes{}
+			injector, _ := NewInjector(&routerRoutes{router: router})
+			derived, _ := From(injector, &routerRoutes{router: router, prefix: "/api"})
+			otherRouterInjector, _ := From(injector, &routerRoutes{router: &recordingRoutes{}, prefix: "/api"})
+
+			assert.Nil(t, injector.GET("/api/users/:id", testHandlerFn))
+			assert.IsType(t, Error{}, derived.GET("/users/:name", testHandlerFn))
+			assert.Nil(t, derived.GET("/users", testHandlerFn))
+			assert.IsType(t, Error{}, injector.GET("/api/users", testHandlerFn))
+			assert.Nil(t, otherRouterInjector.GET("/users/:name", testHandlerFn))
+		},
+		"fail registering Controller with route conflicting with registered route and register none of its routes": func(t *testing.T) {
+			routes := &recordingRoutes{}
+			injector, _ := NewInjector(routes)
+
+			injector.Any("/c", testHandlerFn)
+			routes.routes = nil
+
+			conflictErr := injector.RegisterController(new(sortedRoutesController))
+
+			assert.IsType(t, Error{}, conflictErr)
+			assert.Contains(t, conflictErr.Error(), "*injection.sortedRoutesController.GetTest")
+			assert.Empty(t, routes.routes)
+		},
+		"should allow same path for different http methods and groups": func(t *testing.T) {
+			injector, _ := NewInjector(&groupRoutes{})
+
+			assert.Nil(t, injector.GET("/users", testHandlerFn))
+			assert.Nil(t, injector.POST("/users", testHandlerFn))
+			assert.Nil(t, injector.Group("/v1").GET("/users", testHandlerFn))
+			assert.IsType(t, Error{}, injector.Group("/v1").GET("/users", testHandlerFn))
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestInjector_RegisterController_Lifetime(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"should inject request values to pooled Controller and reset them after request": func(t *testing.T) {
+			injector, routes := setupBenchmarkInjector()
+			handled := make([]*lifetimeController, 0)
+
+			registrationErr := injector.RegisterController(
+				NewPooledController(&lifetimeController{Constant: test.Constant, handled: &handled}),
+			)
+
+			routes.callHandlers()
+			routes.callHandlers()
+
+			assert.Nil(t, registrationErr)
+			assert.Len(t, handled, 2)
+
+			for _, controller := range handled {
+				assert.Nil(t, controller.Dependency, "request values should be reset after request")
+				assert.Equal(t, test.Constant, controller.Constant)
+			}
+		},
+		"should reset pooled Controller state left by request handling and keep static values": func(t *testing.T) {
+			injector, routes := setupBenchmarkInjector()
+			counts := make([]int, 0)
+			controller := &statefulController{Constant: test.Constant, counts: &counts}
+
+			registrationErr := injector.RegisterController(NewPooledController(controller))
+
+			routes.callHandlers()
+			routes.callHandlers()
+
+			assert.Nil(t, registrationErr)
+			assert.Equal(t, []int{1, 1}, counts)
+			assert.Equal(t, 0, controller.requestCount)
+		},
+		"should handle all requests with single singleton Controller instance": func(t *testing.T) {
+			routes := &benchmarkRoutes{}
+			injector, _ := NewInjector(routes)
+			handled := make([]*lifetimeController, 0)
+
+			injector.RegisterProviders(NewSingletonProvider(func() *test.DependencyStruct { return &test.DependencyStruct{} }))
+			registrationErr := injector.RegisterController(
+				NewSingletonController(&lifetimeController{Constant: test.Constant, handled: &handled}),
+			)
+
+			routes.callHandlers()
+			routes.callHandlers()
+
+			assert.Nil(t, registrationErr)
+			assert.Len(t, handled, 2)
+			assert.True(t, handled[0] == handled[1], "requests should be handled by same Controller instance")
+			assert.NotNil(t, handled[0].Dependency)
+			assert.Equal(t, test.Constant, handled[0].Constant)
+		},
+		"should create singleton Controller again on next request when its creation fails": func(t *testing.T) {
+			routes := &benchmarkRoutes{}
+			injector, _ := NewInjector(routes)
+			handled := make([]*lifetimeController, 0)
+			calls := 0
+
+			injector.RegisterProviders(NewSingletonProvider(func() *test.DependencyStruct {
+				if calls++; calls == 1 {
+					panic("connection refused")
+				}
+
+				return &test.DependencyStruct{}
+			}))
+			registrationErr := injector.RegisterController(
+				NewSingletonController(&lifetimeController{Constant: test.Constant, handled: &handled}),
+			)
+
+			routes.callHandlers()
+			routes.callHandlers()
+			routes.callHandlers()
+
+			assert.Nil(t, registrationErr)
+			assert.Len(t, handled, 2)
+			assert.True(t, handled[0] == handled[1], "requests should be handled by same Controller instance")
+			assert.NotNil(t, handled[0].Dependency)
+		},
+		"fail registering singleton Controller with action hooks": func(t *testing.T) {
+			injector, _ := NewInjector(&benchmarkRoutes{})
+
+			registrationErr := injector.RegisterController(NewSingletonController(&hookedLifetimeController{
+				lifetimeController{Dependency: &test.DependencyStruct{}, handled: new([]*lifetimeController)},
+			}))
+
+			assert.IsType(t, Error{}, registrationErr)
+			assert.Contains(t, registrationErr.Error(), "BeforeAction")
+		},
+		"fail registering singleton Controller with request lifetime field dependency": func(t *testing.T) {
+			injector, _ := setupBenchmarkInjector()
+
+			registrationErr := injector.RegisterController(
+				NewSingletonController(&lifetimeController{handled: new([]*lifetimeController)}),
+			)
+
+			assert.IsType(t, Error{}, registrationErr)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
 func TestNewInjector(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		"should register new injector": func(t *testing.T) {
@@ -392,6 +833,39 @@ func TestNewInjector(t *testing.T) {
 
 			assert.NotNil(t, setupErr)
 		},
+		"should register injector seeding several values extracted from non context request handler params": func(t *testing.T) {
+			routes := &seedRoutes{}
+			injector, setupErr := NewInjector(routes)
+			ctx := context.WithValue(context.Background(), test.CtxKey, test.CtxVal)
+			handled := false
+
+			injector.RegisterProviders(func(ctx context.Context) *test.DependencyStruct { return &test.DependencyStruct{Ctx: ctx} })
+			registrationErr := injector.Handle(test.HttpMethod, test.Endpoint, func(
+				name string,
+				count int,
+				valueRequiringContext *test.DependencyStruct,
+			) {
+				handled = true
+
+				assert.Equal(t, test.Constant, name)
+				assert.Equal(t, 2, count)
+				assert.Equal(t, ctx, valueRequiringContext.Ctx)
+			})
+
+			routes.handlers[0].Call([]reflect.Value{
+				reflect.ValueOf(&seedRequest{ctx: ctx, name: test.Constant}),
+				reflect.ValueOf(2),
+			})
+
+			assert.Nil(t, setupErr)
+			assert.Nil(t, registrationErr)
+			assert.True(t, handled)
+		},
+		"should fail to register injector with routes seeding no context value": func(t *testing.T) {
+			_, setupErr := NewInjector(&nonContextSeedRoutes{})
+
+			assert.IsType(t, Error{}, setupErr)
+		},
 	}
 
 	for testName, testCase := range testCases {
@@ -480,3 +954,93 @@ func TestFrom(t *testing.T) {
 	// Old injector does not have previously registered test.DependencyInterface
 	assert.NotNil(t, injectorFrom.RegisterProviders(func(test.DependencyInterface) int { return 1 }))
 }
+
+type benchmarkRoutes struct {
+	testRoutes
+	handlers []reflect.Value
+}
+
+func (r *benchmarkRoutes) Use(handlerFnValues ...reflect.Value) Routes {
+	return r
+}
+
+func (r *benchmarkRoutes) Handle(httpMethod string, endPoint string, handlerFnValues ...reflect.Value) Routes {
+	r.handlers = append(r.handlers, handlerFnValues...)
+
+	return r
+}
+
+func (r *benchmarkRoutes) callHandlers() {
+	ctxValue := []reflect.Value{reflect.ValueOf(context.WithValue(context.Background(), test.CtxKey, test.CtxVal))}
+
+	for _, handler := range r.handlers {
+		handler.Call(ctxValue)
+	}
+}
+
+func (r *benchmarkRoutes) serve(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		r.callHandlers()
+	}
+}
+
+func setupBenchmarkInjector() (*Injector, *benchmarkRoutes) {
+	routes := &benchmarkRoutes{}
+	injector, _ := NewInjector(routes)
+
+	injector.RegisterProviders(
+		func(ctx context.Context) *test.DependencyStruct { return &test.DependencyStruct{Ctx: ctx} },
+		func(dependency *test.DependencyStruct) test.DependencyInterface { return dependency },
+		NewSingletonProvider(func() string { return test.Constant }),
+	)
+
+	return injector, routes
+}
+
+func BenchmarkInjector_Handle(b *testing.B) {
+	injector, routes := setupBenchmarkInjector()
+
+	injector.Handle(http.MethodGet, test.Endpoint, func(
+		ctx context.Context,
+		valueWithInnerDependency test.DependencyInterface,
+		valueRequiringContext *test.DependencyStruct,
+		providedConstant string,
+	) {
+	})
+
+	routes.serve(b)
+}
+
+type benchmarkController struct {
+	BaseController
+
+	Dependency *test.DependencyStruct
+
+	Constant string
+}
+
+func (c *benchmarkController) Routes() map[string][]string {
+	return map[string][]string{test.Endpoint: {"GetTest"}}
+}
+
+func (c *benchmarkController) GetTest(ctx context.Context, valueWithInnerDependency test.DependencyInterface) {
+}
+
+func BenchmarkInjector_RegisterController(b *testing.B) {
+	injector, routes := setupBenchmarkInjector()
+
+	injector.RegisterController(&benchmarkController{Constant: test.Constant})
+
+	routes.serve(b)
+}
+
+func BenchmarkInjector_RegisterController_Pooled(b *testing.B) {
+	injector, routes := setupBenchmarkInjector()
+
+	injector.RegisterController(NewPooledController(&benchmarkController{Constant: test.Constant}))
+
+	routes.serve(b)
+}
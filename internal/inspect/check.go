@@ -0,0 +1,192 @@
+package inspect
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"sort"
+)
+
+// Issue describes injection wiring error found by Check function,
+// Unknown issues can not be verified as some providers are not known statically
+type Issue struct {
+	Pos     token.Position
+	Message string
+	Unknown bool
+}
+
+// String returns issue in file:line:column: message format
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Pos, i.Message)
+}
+
+// Check reports every provider dependency, handler parameter and controller field which
+// can not be satisfied by seeded types, types bound from request, values stored into request scope by middleware
+// or any value provider registered in given packages, along with controller routes mapped to unknown methods.
+// Values registered in any of given packages are available for all of them, as injectors are commonly shared
+// between packages. Unsatisfied values are reported as Unknown issues when any of given packages registers
+// providers which can not be inspected statically
+func Check(wirings ...*Wiring) []Issue {
+	issues := make([]Issue, 0)
+	provided := map[string]bool{"*" + injectionPath + ".Scope": true}
+	var unknownProviders []token.Position
+
+	for _, wiring := range wirings {
+		wiring.provide(provided)
+		unknownProviders = append(unknownProviders, wiring.UnknownProviders...)
+	}
+
+	for _, wiring := range wirings {
+		issues = append(issues, wiring.check(provided, unknownProviders)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Pos.Filename == issues[j].Pos.Filename {
+			return issues[i].Pos.Offset < issues[j].Pos.Offset
+		}
+
+		return issues[i].Pos.Filename < issues[j].Pos.Filename
+	})
+
+	return issues
+}
+
+// provide marks types provided by the wiring in given set of provided type names
+func (w *Wiring) provide(provided map[string]bool) {
+	for _, seed := range w.Seeds {
+		provided[seed] = true
+	}
+
+	for _, provider := range w.Providers {
+		provided[TypeString(provider.Result)] = true
+	}
+
+	for _, binding := range w.RouteBindings {
+		provided[TypeString(binding.Result)] = true
+	}
+
+	for _, scopeValue := range w.ScopeValues {
+		provided[TypeString(scopeValue)] = true
+	}
+
+	for _, handler := range w.Handlers {
+		if handler.Provides != nil {
+			provided[TypeString(handler.Provides)] = true
+		}
+	}
+
+	for _, controller := range w.Controllers {
+		for _, action := range controller.Actions {
+			for _, handler := range action.Middleware {
+				if handler.Provides != nil {
+					provided[TypeString(handler.Provides)] = true
+				}
+			}
+		}
+	}
+
+	for _, bindable := range w.Bindables {
+		provided[TypeString(bindable)] = true
+		provided[TypeString(types.NewPointer(bindable))] = true
+	}
+}
+
+// check reports values the wiring injects which are not in given set of provided type names,
+// as Unknown issues when given positions of providers which can not be inspected statically are not empty.
+// Reports controller routes mapped to unknown methods
+func (w *Wiring) check(provided map[string]bool, unknownProviders []token.Position) []Issue {
+	var issues []Issue
+
+	unsatisfied := func(pos token.Position, valueType types.Type, format string, args ...interface{}) {
+		if provided[TypeString(valueType)] || isBound(valueType) {
+			return
+		}
+
+		if len(unknownProviders) > 0 {
+			issues = append(issues, Issue{
+				Pos: pos,
+				Message: fmt.Sprintf(format, args...) +
+					" is unknown, it may be satisfied by provider registered at " + unknownProviders[0].String(),
+				Unknown: true,
+			})
+
+			return
+		}
+
+		issues = append(issues, Issue{
+			Pos:     pos,
+			Message: fmt.Sprintf(format, args...) + " can not be satisfied by any registered provider",
+		})
+	}
+
+	for _, provider := range append(append([]*Provider{}, w.Providers...), w.RouteBindings...) {
+		for _, param := range provider.Params {
+			unsatisfied(provider.Pos, param, "provider %s dependency %s", TypeString(provider.Result), TypeString(param))
+		}
+	}
+
+	for _, handler := range w.Handlers {
+		for _, param := range handler.Params {
+			unsatisfied(handler.Pos, param, "%s handler parameter %s", handler.Route, TypeString(param))
+		}
+	}
+
+	for _, controller := range w.Controllers {
+		for _, field := range controller.Fields {
+			unsatisfied(
+				controller.Pos,
+				field.Type(),
+				"controller %s field %s of type %s",
+				TypeString(controller.Type),
+				field.Name(),
+				TypeString(field.Type()),
+			)
+		}
+
+		for _, action := range controller.Actions {
+			if action.Method == nil {
+				issues = append(issues, Issue{
+					Pos:     action.Pos,
+					Message: fmt.Sprintf("controller %s has no request handler method %s", TypeString(controller.Type), action.MethodName),
+				})
+
+				continue
+			}
+
+			for _, handler := range action.Middleware {
+				for _, param := range handler.Params {
+					unsatisfied(handler.Pos, param, "%s middleware parameter %s", action.Route, TypeString(param))
+				}
+			}
+
+			for _, param := range tupleTypes(action.Method.Type().(*types.Signature).Params()) {
+				unsatisfied(action.Pos, param, "%s %s parameter %s", action.Route, action.MethodName, TypeString(param))
+			}
+		}
+	}
+
+	return issues
+}
+
+// isBound reports whether struct type or pointer to struct type embeds injection.Body, injection.Params
+// or injection.FormRequest, values of such types are bound from request
+func isBound(valueType types.Type) bool {
+	structType, ok := derefType(valueType).Underlying().(*types.Struct)
+
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < structType.NumFields(); i++ {
+		if !structType.Field(i).Embedded() {
+			continue
+		}
+
+		switch TypeString(structType.Field(i).Type()) {
+		case injectionPath + ".Body", injectionPath + ".Params", injectionPath + ".FormRequest":
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package inspect
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	pkgs, err := Load("testdata/wiring", ".")
+
+	assert.Nil(t, err)
+	assert.Len(t, pkgs, 1)
+	assert.Empty(t, pkgs[0].Errors)
+
+	wiring := Collect(pkgs[0])
+
+	assert.Len(t, wiring.Providers, 2)
+	assert.Len(t, wiring.Bindables, 1)
+	assert.Len(t, wiring.RouteBindings, 1)
+	assert.True(t, wiring.Providers[0].Singleton)
+	assert.Len(t, wiring.ScopeValues, 1)
+	assert.Len(t, wiring.Handlers, 9)
+	assert.Equal(t, "PUT /users/:id", wiring.Handlers[2].Route)
+	assert.Equal(t, "POST /ping", wiring.Handlers[3].Route)
+	assert.Equal(t, "ANY /echo", wiring.Handlers[4].Route)
+	assert.Equal(t, "middleware", wiring.Handlers[5].Route)
+	assert.NotNil(t, wiring.Handlers[6].Provides)
+	assert.Nil(t, wiring.Handlers[7].Provides)
+	assert.Equal(t, "GET /me", wiring.Handlers[8].Route)
+	assert.Nil(t, wiring.Handlers[8].Provides)
+	assert.Len(t, wiring.Controllers, 1)
+	assert.Len(t, wiring.Controllers[0].Fields, 2)
+	assert.Len(t, wiring.Controllers[0].Actions, 4)
+
+	var messages []string
+
+	for _, issue := range Check(wiring) {
+		assert.True(t, strings.HasSuffix(issue.Pos.Filename, "wiring.go"))
+		messages = append(messages, issue.Message)
+	}
+
+	assert.Equal(t, []string{
+		"controller github.com/surmus/injection/internal/inspect/testdata/wiring.UserController has no request handler method DeleteUsers",
+		"POST /api/users middleware parameter context.Context can not be satisfied by any registered provider",
+		"provider *github.com/surmus/injection/internal/inspect/testdata/wiring.User dependency *net/http.Client " +
+			"can not be satisfied by any registered provider",
+		"provider github.com/surmus/injection/internal/inspect/testdata/wiring.Mailer dependency *net/http.Client " +
+			"can not be satisfied by any registered provider",
+		"GET /status handler parameter *net/http.Client can not be satisfied by any registered provider",
+		"POST /api/users/:id/archive ArchiveUser parameter *net/http.Client can not be satisfied by any registered provider",
+	}, messages)
+}
+
+func TestCheck_LoadSet(t *testing.T) {
+	load := func(t *testing.T, patterns ...string) []*Wiring {
+		pkgs, err := Load("testdata", patterns...)
+
+		assert.Nil(t, err)
+		assert.Len(t, pkgs, len(patterns))
+
+		var wirings []*Wiring
+
+		for _, pkg := range pkgs {
+			assert.Empty(t, pkg.Errors)
+			wirings = append(wirings, Collect(pkg))
+		}
+
+		return wirings
+	}
+
+	testCases := map[string]func(t *testing.T){
+		"values provided by providers registered in another package of the load set": func(t *testing.T) {
+			for _, issue := range Check(load(t, "./wiring", "./clients")...) {
+				assert.NotContains(t, issue.Message, "*net/http.Client")
+				assert.False(t, issue.Unknown)
+			}
+		},
+		"report unsatisfied values as unknown when providers are spread from slice": func(t *testing.T) {
+			wirings := load(t, "./wiring", "./spread")
+			clientIssues := 0
+
+			assert.Len(t, wirings[1].UnknownProviders, 1)
+
+			for _, issue := range Check(wirings...) {
+				assert.True(t, issue.Unknown || strings.Contains(issue.Message, "has no request handler method"))
+
+				if strings.Contains(issue.Message, "*net/http.Client") {
+					clientIssues++
+					assert.Contains(t, issue.Message, "is unknown, it may be satisfied by provider registered at")
+				}
+			}
+
+			assert.Equal(t, 4, clientIssues)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
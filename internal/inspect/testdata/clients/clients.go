@@ -0,0 +1,14 @@
+package clients
+
+import (
+	"github.com/surmus/injection"
+	"net/http"
+)
+
+func provideClient() *http.Client {
+	return http.DefaultClient
+}
+
+func Register(injector *injection.Injector) {
+	injector.RegisterProviders(provideClient)
+}
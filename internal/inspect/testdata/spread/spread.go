@@ -0,0 +1,12 @@
+package spread
+
+import (
+	"github.com/surmus/injection"
+	"net/http"
+)
+
+var providers = []injection.Provider{func() *http.Client { return http.DefaultClient }}
+
+func Register(injector *injection.Injector) {
+	injector.RegisterProviders(providers...)
+}
@@ -0,0 +1,117 @@
+package wiring
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/surmus/injection"
+	injectiongin "github.com/surmus/injection/gin"
+	"net/http"
+)
+
+type Repository struct{}
+
+type CreateUserRequest struct {
+	injection.Body
+
+	Name string `json:"name"`
+}
+
+type User struct{}
+
+type UpdateUserRequest struct {
+	injection.FormRequest
+
+	Name string `json:"name"`
+}
+
+func (r *UpdateUserRequest) Authorize(ctx *gin.Context, repository *Repository) bool {
+	return true
+}
+
+type UserParams struct {
+	injection.Params
+
+	ID int `path:"id"`
+}
+
+type Principal struct{}
+
+type Tenant struct{}
+
+type Credentials struct {
+	Login string `json:"login"`
+}
+
+type Mailer interface {
+	Send(to string)
+}
+
+type UserController struct {
+	injection.BaseController
+
+	Repository *Repository
+
+	Mailer Mailer
+
+	logger func(string)
+}
+
+func NewUserController() *UserController {
+	return &UserController{logger: func(string) {}}
+}
+
+func (c *UserController) Routes() map[string][]string {
+	return map[string][]string{"/users": {"GetUsers", "PostUser", "DeleteUsers"}}
+}
+
+func (c *UserController) BasePath() string {
+	return "/api"
+}
+
+func (c *UserController) ControllerMiddleware() []injection.Handler {
+	return []injection.Handler{func(ctx *gin.Context, repository *Repository) {}}
+}
+
+func (c *UserController) Middleware() map[string][]injection.Handler {
+	return map[string][]injection.Handler{"PostUser": {func(ctx context.Context) {}}}
+}
+
+func (c *UserController) GetUsers(ctx *gin.Context, repository *Repository, user *User) {}
+
+func (c *UserController) PostUser(ctx *gin.Context, mailer Mailer, request *CreateUserRequest) {}
+
+func (c *UserController) ArchiveUser(ctx *gin.Context, client *http.Client, params UserParams) {}
+
+func provideRepository(ctx *gin.Context) *Repository {
+	return &Repository{}
+}
+
+func provideMailer(db *http.Client) Mailer {
+	return nil
+}
+
+func Setup() {
+	injector := injectiongin.Adapt(gin.New())
+	injection.RegisterBindable[Credentials]()
+	injection.BindRoute[*User](injector, "user", func(repository *Repository, client *http.Client, id string) (*User, error) {
+		return nil, nil
+	})
+
+	injector.RegisterProviders(injection.NewSingletonProvider(provideRepository), provideMailer)
+	injector.Use(func(ctx *gin.Context, repository *Repository) {})
+	injector.Handle(http.MethodGet, "/status", func(ctx *gin.Context, client *http.Client) {})
+	injector.PUT("/users/:id", func(request *UpdateUserRequest) {})
+	injector.POST("/ping", func(ctx *gin.Context, repository *Repository, credentials *Credentials) {})
+	injector.Group("/v1", func(mailer Mailer) {}).Any("/echo", func(ctx *gin.Context) {})
+	injector.RegisterController(
+		injection.NewPooledController(NewUserController()),
+		injection.Route(http.MethodPost, "/users/:id/archive", (*UserController).ArchiveUser),
+	)
+
+	injection.RegisterScopeValue[*Tenant](injector)
+	injector.Use(func(ctx *gin.Context) (*Principal, error) { return &Principal{}, nil })
+	injector.Use(func(principal *Principal) bool { return true })
+	injector.GET("/me", func(principal *Principal, tenant *Tenant, scope *injection.Scope) *Principal {
+		return principal
+	})
+}
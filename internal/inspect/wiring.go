@@ -0,0 +1,734 @@
+// Package inspect statically collects injection wiring of Go packages: value providers registered with
+// Injector RegisterProviders method, request handlers registered with Handle and Use methods and controllers
+// registered with RegisterController method
+package inspect
+
+import (
+	"github.com/fatih/camelcase"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"golang.org/x/tools/go/packages"
+	"strings"
+)
+
+const injectionPath = "github.com/surmus/injection"
+
+// adapterSeeds maps adapter packages to types their Routes implementation seeds into request resolution
+var adapterSeeds = map[string][]string{
+	injectionPath + "/gin": {"*github.com/gin-gonic/gin.Context"},
+	injectionPath + "/nethttp": {
+		"*" + injectionPath + "/nethttp.Context",
+		"net/http.ResponseWriter",
+		"*net/http.Request",
+		"context.Context",
+	},
+}
+
+var httpMethods = []string{"POST", "GET", "DELETE", "PUT", "CONNECT", "HEAD", "OPTIONS", "PATCH", "TRACE"}
+
+// Provider is value provider function registered with Injector RegisterProviders method
+type Provider struct {
+	Pos       token.Position
+	Expr      ast.Expr
+	Result    types.Type
+	Params    []types.Type
+	Singleton bool
+}
+
+// Handler is request handler or middleware function registered with Injector Handle or Use method
+// or returned from Controller Middleware method
+type Handler struct {
+	Pos    token.Position
+	Route  string
+	Expr   ast.Expr
+	Params []types.Type
+	// Provides is type of value middleware stores into request scope by returning it, nil for request handlers
+	// and middleware returning no value
+	Provides types.Type
+}
+
+// Controller is Controller implementation registered with Injector RegisterController method
+type Controller struct {
+	Pos     token.Position
+	Expr    ast.Expr
+	Type    *types.Named
+	Pointer bool
+	// Fields contains controller fields injected from value providers, nil when controller value is not known statically
+	Fields []*types.Var
+	// Actions contains routes returned by Controller Routes method, nil when routes are not known statically
+	Actions []*Action
+}
+
+// Action is Controller request handler method mapped to route by Controller Routes method
+type Action struct {
+	Pos        token.Position
+	Route      string
+	MethodName string
+	Method     *types.Func
+	Middleware []*Handler
+}
+
+// Wiring holds all injection registrations found in a package
+type Wiring struct {
+	Package     *packages.Package
+	Seeds       []string
+	Providers   []*Provider
+	Handlers    []*Handler
+	Controllers []*Controller
+	// Bindables contains types registered with RegisterBindable function
+	Bindables []types.Type
+	// RouteBindings contains route binding resolvers registered with BindRoute function,
+	// resolver params exclude the last param receiving route parameter value
+	RouteBindings []*Provider
+	// ScopeValues contains types registered with RegisterScopeValue function
+	ScopeValues []types.Type
+	// UnknownProviders contains positions of providers registered with RegisterProviders method which can not be
+	// inspected statically, such as providers passed as interface{} values or spread from slice: providers...
+	UnknownProviders []token.Position
+}
+
+// Load loads packages matching given patterns with syntax and type information required by Collect function
+func Load(dir string, patterns ...string) ([]*packages.Package, error) {
+	config := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax |
+			packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps,
+		Dir: dir,
+	}
+
+	return packages.Load(config, patterns...)
+}
+
+// Collect walks package syntax and gathers injection registrations,
+// seeds contains types seeded by Routes implementations which are not imported from known adapter packages
+func Collect(pkg *packages.Package, seeds ...string) *Wiring {
+	wiring := &Wiring{Package: pkg, Seeds: seeds}
+
+	for importPath := range pkg.Imports {
+		wiring.Seeds = append(wiring.Seeds, adapterSeeds[importPath]...)
+	}
+
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok {
+				wiring.collectCall(call)
+			}
+
+			return true
+		})
+	}
+
+	return wiring
+}
+
+// TypeString returns fully qualified type name
+func TypeString(valueType types.Type) string {
+	return types.TypeString(valueType, nil)
+}
+
+// LookupType finds type by its fully qualified name among package and its imports, returns nil when type is not found
+func (w *Wiring) LookupType(qualifiedName string) types.Type {
+	if strings.HasPrefix(qualifiedName, "*") {
+		if elemType := w.LookupType(qualifiedName[1:]); elemType != nil {
+			return types.NewPointer(elemType)
+		}
+
+		return nil
+	}
+
+	separatorIndex := strings.LastIndex(qualifiedName, ".")
+
+	if separatorIndex < 0 {
+		return nil
+	}
+
+	pkg := lookupPackage(w.Package, qualifiedName[:separatorIndex], make(map[string]bool))
+
+	if pkg == nil {
+		return nil
+	}
+
+	if typeName, ok := pkg.Types.Scope().Lookup(qualifiedName[separatorIndex+1:]).(*types.TypeName); ok {
+		return typeName.Type()
+	}
+
+	return nil
+}
+
+func lookupPackage(pkg *packages.Package, pkgPath string, visited map[string]bool) *packages.Package {
+	if pkg.PkgPath == pkgPath {
+		return pkg
+	}
+
+	visited[pkg.PkgPath] = true
+
+	for _, imported := range pkg.Imports {
+		if visited[imported.PkgPath] {
+			continue
+		}
+
+		if found := lookupPackage(imported, pkgPath, visited); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+func (w *Wiring) collectCall(call *ast.CallExpr) {
+	if index, ok := call.Fun.(*ast.IndexExpr); ok {
+		switch {
+		case isInjectionFunc(w.Package.TypesInfo, index.X, "RegisterBindable"):
+			w.Bindables = append(w.Bindables, w.Package.TypesInfo.TypeOf(index.Index))
+		case isInjectionFunc(w.Package.TypesInfo, index.X, "RegisterScopeValue"):
+			w.ScopeValues = append(w.ScopeValues, w.Package.TypesInfo.TypeOf(index.Index))
+		case isInjectionFunc(w.Package.TypesInfo, index.X, "BindRoute") && len(call.Args) == 3:
+			if binding := w.routeBinding(w.Package.TypesInfo.TypeOf(index.Index), call.Args[2]); binding != nil {
+				w.RouteBindings = append(w.RouteBindings, binding)
+			}
+		}
+
+		return
+	}
+
+	methodName := injectorMethodName(w.Package.TypesInfo, call)
+
+	switch {
+	case methodName == "RegisterProviders":
+		for _, arg := range call.Args {
+			provider := w.provider(arg)
+
+			// provider slices spread into variadic arguments are not inspected either
+			if provider == nil {
+				w.UnknownProviders = append(w.UnknownProviders, w.position(arg))
+				continue
+			}
+
+			w.Providers = append(w.Providers, provider)
+		}
+	case methodName == "Use":
+		w.Handlers = append(w.Handlers, w.handlers("middleware", call.Args)...)
+	case methodName == "Group" && len(call.Args) >= 1:
+		w.Handlers = append(w.Handlers, w.handlers("middleware", call.Args[1:])...)
+	case methodName == "Handle" && len(call.Args) >= 2:
+		route := w.constString(call.Args[0]) + " " + w.constString(call.Args[1])
+		w.Handlers = append(w.Handlers, w.routeHandlers(route, call.Args[2:])...)
+	case (isHTTPMethod(methodName) || methodName == "Any") && len(call.Args) >= 1:
+		route := strings.ToUpper(methodName) + " " + w.constString(call.Args[0])
+		w.Handlers = append(w.Handlers, w.routeHandlers(route, call.Args[1:])...)
+	case methodName == "RegisterController" && len(call.Args) >= 1:
+		if controller := w.controller(call.Args[0]); controller != nil {
+			controller.Actions = append(controller.Actions, w.methodExprActions(controller, call.Args[1:])...)
+			w.Controllers = append(w.Controllers, controller)
+		}
+	}
+}
+
+func (w *Wiring) provider(expr ast.Expr) *Provider {
+	provider := &Provider{Pos: w.position(expr), Expr: expr}
+
+	if call, ok := expr.(*ast.CallExpr); ok && isInjectionFunc(w.Package.TypesInfo, call.Fun, "NewSingletonProvider") {
+		provider.Singleton = true
+		provider.Expr = call.Args[0]
+	}
+
+	signature, ok := w.Package.TypesInfo.TypeOf(provider.Expr).Underlying().(*types.Signature)
+
+	if !ok || signature.Results().Len() != 1 {
+		return nil
+	}
+
+	provider.Result = signature.Results().At(0).Type()
+	provider.Params = tupleTypes(signature.Params())
+
+	return provider
+}
+
+func (w *Wiring) routeBinding(result types.Type, expr ast.Expr) *Provider {
+	signature, ok := w.Package.TypesInfo.TypeOf(expr).Underlying().(*types.Signature)
+
+	if !ok || signature.Params().Len() == 0 {
+		return nil
+	}
+
+	params := tupleTypes(signature.Params())
+
+	return &Provider{Pos: w.position(expr), Expr: expr, Result: result, Params: params[:len(params)-1]}
+}
+
+func (w *Wiring) handlers(route string, exprs []ast.Expr) []*Handler {
+	var handlers []*Handler
+
+	for _, expr := range exprs {
+		signature, ok := w.Package.TypesInfo.TypeOf(expr).Underlying().(*types.Signature)
+
+		// handlers passed as interface{} values can not be inspected statically
+		if !ok {
+			continue
+		}
+
+		handler := &Handler{
+			Pos:    w.position(expr),
+			Route:  route,
+			Expr:   expr,
+			Params: tupleTypes(signature.Params()),
+		}
+
+		handler.Provides = providedType(signature.Results())
+
+		handlers = append(handlers, handler)
+	}
+
+	return handlers
+}
+
+// routeHandlers returns route middleware followed by request handler, return values of the last handler
+// are rendered into http response instead of stored into request scope
+func (w *Wiring) routeHandlers(route string, exprs []ast.Expr) []*Handler {
+	handlers := w.handlers(route, exprs)
+
+	if len(handlers) > 0 && len(handlers) == len(exprs) {
+		handlers[len(handlers)-1].Provides = nil
+	}
+
+	return handlers
+}
+
+func (w *Wiring) controller(expr ast.Expr) *Controller {
+	// pooled and singleton controllers are registered wrapped by Controller lifetime functions
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) == 1 &&
+		(isInjectionFunc(w.Package.TypesInfo, call.Fun, "NewPooledController") ||
+			isInjectionFunc(w.Package.TypesInfo, call.Fun, "NewSingletonController")) {
+		expr = call.Args[0]
+	}
+
+	controller := &Controller{Pos: w.position(expr), Expr: expr}
+	ctrlType := w.Package.TypesInfo.TypeOf(expr)
+
+	if pointer, ok := ctrlType.(*types.Pointer); ok {
+		controller.Pointer = true
+		ctrlType = pointer.Elem()
+	}
+
+	named, ok := ctrlType.(*types.Named)
+
+	if !ok {
+		return nil
+	}
+
+	controller.Type = named
+
+	if literal := w.controllerLiteral(expr); literal != nil {
+		controller.Fields = injectedFields(named, literal)
+	}
+
+	controller.Actions = w.controllerActions(controller)
+
+	return controller
+}
+
+// controllerLiteral finds composite literal controller value is created from,
+// supports literals given directly and returned from constructor functions declared in same package
+func (w *Wiring) controllerLiteral(expr ast.Expr) *ast.CompositeLit {
+	switch expr := unparen(expr).(type) {
+	case *ast.CompositeLit:
+		return expr
+	case *ast.UnaryExpr:
+		if expr.Op == token.AND {
+			return w.controllerLiteral(expr.X)
+		}
+	case *ast.CallExpr:
+		constructor, ok := w.Package.TypesInfo.Uses[calleeIdent(expr.Fun)].(*types.Func)
+
+		if !ok {
+			return nil
+		}
+
+		if decl := w.funcDecl(constructor); decl != nil && decl.Body != nil {
+			if returnStmt := lastReturn(decl.Body); returnStmt != nil && len(returnStmt.Results) == 1 {
+				return w.controllerLiteral(returnStmt.Results[0])
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *Wiring) controllerActions(controller *Controller) []*Action {
+	routesDecl := w.methodDecl(controller.Type, "Routes")
+	routesLiteral := returnedLiteral(routesDecl)
+
+	if routesLiteral == nil {
+		return nil
+	}
+
+	actions := make([]*Action, 0)
+	middleware := w.controllerMiddleware(controller.Type)
+	basePath := w.controllerBasePath(controller.Type)
+	methodSet := controllerMethodSet(controller)
+
+	for _, element := range routesLiteral.Elts {
+		keyValue, ok := element.(*ast.KeyValueExpr)
+
+		if !ok {
+			continue
+		}
+
+		path := w.constString(keyValue.Key)
+		methodNames, ok := keyValue.Value.(*ast.CompositeLit)
+
+		if !ok {
+			continue
+		}
+
+		for _, methodNameExpr := range methodNames.Elts {
+			methodName := w.constString(methodNameExpr)
+			route := handlerHTTPMethod(methodName) + " " + basePath + path
+
+			// route spec with explicit http method: "POST /articles/:id/publish"
+			if specParts := strings.Fields(path); len(specParts) == 2 {
+				route = specParts[0] + " " + basePath + specParts[1]
+			}
+
+			action := newAction(w.position(methodNameExpr), route, methodName, middleware[methodName])
+
+			if selection := methodSet.Lookup(w.Package.Types, methodName); selection != nil {
+				action.Method = selection.Obj().(*types.Func)
+			}
+
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// methodExprActions collects Controller routes declared with injection.Route function from Controller method expressions
+func (w *Wiring) methodExprActions(controller *Controller, routes []ast.Expr) []*Action {
+	var actions []*Action
+
+	middleware := w.controllerMiddleware(controller.Type)
+	methodSet := controllerMethodSet(controller)
+
+	for _, routeExpr := range routes {
+		call, ok := unparen(routeExpr).(*ast.CallExpr)
+
+		if !ok || len(call.Args) < 3 || !isInjectionFunc(w.Package.TypesInfo, call.Fun, "Route") {
+			continue
+		}
+
+		methodExpr, ok := unparen(call.Args[2]).(*ast.SelectorExpr)
+
+		if !ok {
+			continue
+		}
+
+		route := w.constString(call.Args[0]) + " " + w.controllerBasePath(controller.Type) + w.constString(call.Args[1])
+		methodName := methodExpr.Sel.Name
+		routeMiddleware := append(append([]*Handler{}, middleware[methodName]...), w.handlers(route, call.Args[3:])...)
+		action := newAction(w.position(methodExpr), route, methodName, routeMiddleware)
+
+		// method expression of another type does not belong to Controller
+		if selection := w.Package.TypesInfo.Selections[methodExpr]; selection != nil &&
+			types.Identical(derefType(selection.Recv()), controller.Type) {
+			if methodSelection := methodSet.Lookup(w.Package.Types, methodName); methodSelection != nil {
+				action.Method = methodSelection.Obj().(*types.Func)
+			}
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func newAction(pos token.Position, route string, methodName string, middleware []*Handler) *Action {
+	action := &Action{Pos: pos, Route: route, MethodName: methodName}
+
+	for _, handler := range middleware {
+		action.Middleware = append(action.Middleware, &Handler{
+			Pos:    handler.Pos,
+			Route:  route,
+			Expr:   handler.Expr,
+			Params: handler.Params,
+		})
+	}
+
+	return action
+}
+
+// controllerMiddleware returns middleware of Controller methods,
+// Controller level middleware returned by ControllerMiddleware method precedes method middleware
+func (w *Wiring) controllerMiddleware(ctrlType *types.Named) map[string][]*Handler {
+	var sharedMiddleware []*Handler
+
+	if sharedLiteral := returnedLiteral(w.methodDecl(ctrlType, "ControllerMiddleware")); sharedLiteral != nil {
+		sharedMiddleware = w.handlers("controller", sharedLiteral.Elts)
+	}
+
+	middleware := make(map[string][]*Handler)
+	middlewareLiteral := returnedLiteral(w.methodDecl(ctrlType, "Middleware"))
+
+	if middlewareLiteral != nil {
+		for _, element := range middlewareLiteral.Elts {
+			keyValue, ok := element.(*ast.KeyValueExpr)
+
+			if !ok {
+				continue
+			}
+
+			if handlers, ok := keyValue.Value.(*ast.CompositeLit); ok {
+				methodName := w.constString(keyValue.Key)
+				middleware[methodName] = w.handlers(methodName, handlers.Elts)
+			}
+		}
+	}
+
+	for i := 0; i < ctrlType.NumMethods(); i++ {
+		methodName := ctrlType.Method(i).Name()
+		middleware[methodName] = append(append([]*Handler{}, sharedMiddleware...), middleware[methodName]...)
+	}
+
+	return middleware
+}
+
+// controllerBasePath returns constant path returned by Controller BasePath method, empty when not known statically
+func (w *Wiring) controllerBasePath(ctrlType *types.Named) string {
+	decl := w.methodDecl(ctrlType, "BasePath")
+
+	if decl == nil || decl.Body == nil {
+		return ""
+	}
+
+	returnStmt := lastReturn(decl.Body)
+
+	if returnStmt == nil || len(returnStmt.Results) != 1 {
+		return ""
+	}
+
+	if basePath := w.constString(returnStmt.Results[0]); basePath != "?" {
+		return basePath
+	}
+
+	return ""
+}
+
+func (w *Wiring) methodDecl(ctrlType *types.Named, methodName string) *ast.FuncDecl {
+	for i := 0; i < ctrlType.NumMethods(); i++ {
+		if ctrlType.Method(i).Name() == methodName {
+			return w.funcDecl(ctrlType.Method(i))
+		}
+	}
+
+	return nil
+}
+
+func (w *Wiring) funcDecl(fn *types.Func) *ast.FuncDecl {
+	for _, file := range w.Package.Syntax {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && w.Package.TypesInfo.Defs[funcDecl.Name] == fn {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *Wiring) constString(expr ast.Expr) string {
+	if typeAndValue, ok := w.Package.TypesInfo.Types[expr]; ok && typeAndValue.Value != nil {
+		return strings.Trim(typeAndValue.Value.ExactString(), `"`)
+	}
+
+	return "?"
+}
+
+func (w *Wiring) position(node ast.Node) token.Position {
+	return w.Package.Fset.Position(node.Pos())
+}
+
+func injectorMethodName(info *types.Info, call *ast.CallExpr) string {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+
+	if !ok {
+		return ""
+	}
+
+	method, ok := info.Uses[selector.Sel].(*types.Func)
+
+	if !ok || method.Pkg() == nil || method.Pkg().Path() != injectionPath {
+		return ""
+	}
+
+	receiver := method.Type().(*types.Signature).Recv()
+
+	if receiver == nil || !strings.HasSuffix(TypeString(receiver.Type()), injectionPath+".Injector") {
+		return ""
+	}
+
+	return method.Name()
+}
+
+func isInjectionFunc(info *types.Info, fun ast.Expr, name string) bool {
+	fn, ok := info.Uses[calleeIdent(fun)].(*types.Func)
+
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == injectionPath && fn.Name() == name
+}
+
+func calleeIdent(fun ast.Expr) *ast.Ident {
+	switch fun := unparen(fun).(type) {
+	case *ast.Ident:
+		return fun
+	case *ast.SelectorExpr:
+		return fun.Sel
+	}
+
+	return nil
+}
+
+func unparen(expr ast.Expr) ast.Expr {
+	if paren, ok := expr.(*ast.ParenExpr); ok {
+		return unparen(paren.X)
+	}
+
+	return expr
+}
+
+func lastReturn(body *ast.BlockStmt) *ast.ReturnStmt {
+	if len(body.List) == 0 {
+		return nil
+	}
+
+	returnStmt, _ := body.List[len(body.List)-1].(*ast.ReturnStmt)
+
+	return returnStmt
+}
+
+func returnedLiteral(decl *ast.FuncDecl) *ast.CompositeLit {
+	if decl == nil || decl.Body == nil {
+		return nil
+	}
+
+	returnStmt := lastReturn(decl.Body)
+
+	if returnStmt == nil || len(returnStmt.Results) != 1 {
+		return nil
+	}
+
+	literal, _ := unparen(returnStmt.Results[0]).(*ast.CompositeLit)
+
+	return literal
+}
+
+// injectedFields returns nillable struct fields not set in controller literal,
+// mirroring Injector which injects only fields holding nil value
+func injectedFields(ctrlType *types.Named, literal *ast.CompositeLit) []*types.Var {
+	fields := make([]*types.Var, 0)
+	structType, ok := ctrlType.Underlying().(*types.Struct)
+
+	if !ok {
+		return nil
+	}
+
+	setFields := make(map[string]bool)
+
+	for _, element := range literal.Elts {
+		keyValue, ok := element.(*ast.KeyValueExpr)
+
+		// positional literal sets all fields
+		if !ok {
+			return fields
+		}
+
+		if key, ok := keyValue.Key.(*ast.Ident); ok {
+			setFields[key.Name] = true
+		}
+	}
+
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+
+		if !setFields[field.Name()] && isNillable(field.Type()) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+func controllerMethodSet(controller *Controller) *types.MethodSet {
+	if controller.Pointer {
+		return types.NewMethodSet(types.NewPointer(controller.Type))
+	}
+
+	return types.NewMethodSet(controller.Type)
+}
+
+func isNillable(valueType types.Type) bool {
+	switch valueType.Underlying().(type) {
+	case *types.Pointer, *types.Interface, *types.Map, *types.Slice, *types.Chan, *types.Signature:
+		return true
+	}
+
+	return false
+}
+
+// providedType returns type of value middleware with given return values stores into request scope,
+// middleware returns either T or (T, error), error and bool values abort request handling
+func providedType(results *types.Tuple) types.Type {
+	isAbortType := func(valueType types.Type) bool {
+		basic, isBasic := valueType.Underlying().(*types.Basic)
+
+		return TypeString(valueType) == "error" || isBasic && basic.Kind() == types.Bool
+	}
+
+	switch {
+	case results.Len() == 1 && !isAbortType(results.At(0).Type()):
+		return results.At(0).Type()
+	case results.Len() == 2 && TypeString(results.At(1).Type()) == "error":
+		return results.At(0).Type()
+	}
+
+	return nil
+}
+
+func tupleTypes(tuple *types.Tuple) []types.Type {
+	var tupleTypes []types.Type
+
+	for i := 0; i < tuple.Len(); i++ {
+		tupleTypes = append(tupleTypes, tuple.At(i).Type())
+	}
+
+	return tupleTypes
+}
+
+func handlerHTTPMethod(handlerMethodName string) string {
+	nameParts := camelcase.Split(handlerMethodName)
+
+	for _, httpMethod := range httpMethods {
+		if len(nameParts) > 0 && strings.ToUpper(nameParts[0]) == httpMethod {
+			return httpMethod
+		}
+	}
+
+	return "GET"
+}
+
+// isHTTPMethod reports whether Injector method name is request handler registration helper of http method
+func isHTTPMethod(methodName string) bool {
+	for _, httpMethod := range httpMethods {
+		if methodName == httpMethod {
+			return true
+		}
+	}
+
+	return false
+}
+
+func derefType(valueType types.Type) types.Type {
+	if pointer, ok := valueType.(*types.Pointer); ok {
+		return pointer.Elem()
+	}
+
+	return valueType
+}
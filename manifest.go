@@ -0,0 +1,166 @@
+package injection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+)
+
+type manifest struct {
+	Providers  []*providerManifest `json:"providers"`
+	Middleware []*handlerManifest  `json:"middleware"`
+	Routes     []*routeManifest    `json:"routes"`
+}
+
+type providerManifest struct {
+	Type         string   `json:"type"`
+	Signature    string   `json:"signature"`
+	Source       string   `json:"source"`
+	Lifetime     string   `json:"lifetime"`
+	Dependencies []string `json:"dependencies"`
+}
+
+type routeManifest struct {
+	Method   string             `json:"method"`
+	Path     string             `json:"path"`
+	Handlers []*handlerManifest `json:"handlers"`
+}
+
+type handlerManifest struct {
+	Name       string   `json:"name"`
+	Source     string   `json:"source"`
+	Parameters []string `json:"parameters"`
+	Fields     []string `json:"fields,omitempty"`
+}
+
+// Manifest returns JSON document describing Injector wiring:
+// every registered value provider with its signature, source location, lifetime and dependencies,
+// every middleware registered with Use method and every route with its http method, path and injected parameter types.
+// Routes of all Injector groups are described with group path prefix and middleware.
+// Document entries are sorted, so that manifest stays the same between application starts with unchanged wiring
+func (r *Injector) Manifest() ([]byte, error) {
+	document := &manifest{
+		Providers:  make([]*providerManifest, 0),
+		Middleware: make([]*handlerManifest, 0),
+		Routes:     make([]*routeManifest, 0),
+	}
+
+	for _, definition := range r.allProviders() {
+		document.Providers = append(document.Providers, newProviderManifest(definition))
+	}
+
+	sort.Slice(document.Providers, func(i, j int) bool {
+		return document.Providers[i].Type < document.Providers[j].Type
+	})
+
+	for _, definition := range r.root().middlewareDefinitions {
+		document.Middleware = append(document.Middleware, newHandlerManifest(definition))
+	}
+
+	for _, definition := range r.root().routeDefinitions {
+		document.Routes = append(document.Routes, newRouteManifest(definition))
+	}
+
+	sort.SliceStable(document.Routes, func(i, j int) bool {
+		if document.Routes[i].Path == document.Routes[j].Path {
+			return document.Routes[i].Method < document.Routes[j].Method
+		}
+
+		return document.Routes[i].Path < document.Routes[j].Path
+	})
+
+	// signature arrows are not escaped, so that manifest stays readable in diffs
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func newProviderManifest(definition *providerDefinition) *providerManifest {
+	providerManifest := &providerManifest{
+		Type:         definition.kind.String(),
+		Lifetime:     definition.lifetime,
+		Dependencies: typeStrings(definition.dependencies()),
+		Signature:    fmt.Sprintf("() -> %s", definition.kind),
+	}
+
+	if definition.source.IsValid() {
+		providerManifest.Signature = providerString(definition.source.Interface())
+		providerManifest.Source = funcSource(definition.source)
+	} else if definition.fn.IsValid() {
+		providerManifest.Signature = providerString(definition.fn.Interface())
+
+		if !definition.synthesized {
+			providerManifest.Source = funcSource(definition.fn)
+		}
+	}
+
+	return providerManifest
+}
+
+func newRouteManifest(definition *routeDefinition) *routeManifest {
+	routeManifest := &routeManifest{
+		Method:   definition.httpMethod,
+		Path:     definition.endPoint,
+		Handlers: make([]*handlerManifest, 0),
+	}
+
+	for _, handler := range definition.handlers {
+		routeManifest.Handlers = append(routeManifest.Handlers, newHandlerManifest(handler))
+	}
+
+	return routeManifest
+}
+
+func newHandlerManifest(definition *handlerDefinition) *handlerManifest {
+	return &handlerManifest{
+		Name:       definition.name,
+		Source:     funcSource(definition.fn),
+		Parameters: typeStrings(definition.params),
+		Fields:     typeStrings(definition.fields),
+	}
+}
+
+func typeStrings(types []reflect.Type) []string {
+	typeNames := make([]string, 0)
+
+	for _, valueType := range types {
+		typeNames = append(typeNames, valueType.String())
+	}
+
+	return typeNames
+}
+
+// funcSource returns function source location as file base name and line,
+// full file path is omitted to keep location same across different build environments
+func funcSource(fn reflect.Value) string {
+	runtimeFn := runtime.FuncForPC(fn.Pointer())
+
+	if runtimeFn == nil {
+		return ""
+	}
+
+	file, line := runtimeFn.FileLine(runtimeFn.Entry())
+
+	return fmt.Sprintf("%s:%d", filepath.Base(file), line)
+}
+
+func funcName(fn reflect.Value) string {
+	runtimeFn := runtime.FuncForPC(fn.Pointer())
+
+	if runtimeFn == nil {
+		return fn.Type().String()
+	}
+
+	return runtimeFn.Name()
+}
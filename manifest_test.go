@@ -0,0 +1,83 @@
+package injection
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/surmus/injection/test"
+	"net/http"
+	"testing"
+)
+
+func TestInjector_Manifest(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"should describe registered providers": func(t *testing.T) {
+			var document manifest
+
+			injector := setupInjector(t)
+			injector.RegisterProviders(NewSingletonProvider(func() int { return 1 }))
+
+			manifestJSON, err := injector.Manifest()
+			test.MustUnMarshal(manifestJSON, &document)
+
+			assert.Nil(t, err)
+			assert.Len(t, document.Providers, 6)
+
+			assert.Equal(t, "*injection.Scope", document.Providers[0].Type)
+			assert.Equal(t, contextLifetime, document.Providers[0].Lifetime)
+
+			assert.Equal(t, "context.Context", document.Providers[2].Type)
+			assert.Equal(t, contextLifetime, document.Providers[2].Lifetime)
+			assert.Empty(t, document.Providers[2].Dependencies)
+
+			assert.Equal(t, "int", document.Providers[3].Type)
+			assert.Equal(t, singletonLifetime, document.Providers[3].Lifetime)
+
+			assert.Equal(t, "test.DependencyInterface", document.Providers[5].Type)
+			assert.Equal(t, requestLifetime, document.Providers[5].Lifetime)
+			assert.Equal(t, "(*test.DependencyStruct) -> test.DependencyInterface", document.Providers[5].Signature)
+			assert.Equal(t, []string{"*test.DependencyStruct"}, document.Providers[5].Dependencies)
+			assert.Contains(t, document.Providers[5].Source, "injector_test.go:")
+			assert.Contains(t, string(manifestJSON), `"signature": "(*test.DependencyStruct) -> test.DependencyInterface"`)
+		},
+		"should describe registered routes and middleware": func(t *testing.T) {
+			var document manifest
+
+			injector, _ := setupBenchmarkInjector()
+			injector.Use(func(ctx context.Context) {})
+			injector.Handle(http.MethodGet, "/handler"+test.Endpoint, setupTestHandlerFn(t))
+			injector.RegisterController(NewValueController(t))
+
+			manifestJSON, err := injector.Manifest()
+			test.MustUnMarshal(manifestJSON, &document)
+
+			assert.Nil(t, err)
+			assert.Len(t, document.Middleware, 1)
+			assert.Equal(t, []string{"context.Context"}, document.Middleware[0].Parameters)
+			assert.Len(t, document.Routes, 2)
+
+			for _, route := range document.Routes {
+				assert.Equal(t, http.MethodGet, route.Method)
+			}
+
+			assert.Equal(t, "/handler"+test.Endpoint, document.Routes[0].Path)
+			assert.Equal(t, test.Endpoint, document.Routes[1].Path)
+
+			ctrlRoute := document.Routes[1]
+
+			assert.Len(t, ctrlRoute.Handlers, 2)
+			assert.Equal(t, "injection.ValueController.HandleRequest", ctrlRoute.Handlers[1].Name)
+			assert.Equal(t, []string{"context.Context", "test.DependencyInterface"}, ctrlRoute.Handlers[1].Parameters)
+			assert.Equal(t, []string{"*test.DependencyStruct"}, ctrlRoute.Handlers[1].Fields)
+		},
+		"should produce same document for same wiring": func(t *testing.T) {
+			firstManifest, _ := setupInjector(t).Manifest()
+			secondManifest, _ := setupInjector(t).Manifest()
+
+			assert.Equal(t, string(firstManifest), string(secondManifest))
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
@@ -0,0 +1,101 @@
+package injection
+
+import (
+	"reflect"
+)
+
+// AbortRoutes is optional Routes capability for http libraries able to stop executing request handlers of the request,
+// required for middleware returning error or bool values
+type AbortRoutes interface {
+	// Abort prevents calling the rest of request handlers of the request from values seeded into request resolution
+	Abort(seeds []reflect.Value)
+}
+
+// middlewareResults is middleware return values handling compiled at middleware registration time,
+// valueSlot is request scope slot of the value contributed by the middleware,
+// indexes of return values not returned by the middleware are -1
+type middlewareResults struct {
+	valueIndex int
+	valueSlot  int
+	abortIndex int
+}
+
+// compileMiddlewareResults compiles handling of given middleware function return values,
+// returns nil when middleware returns no values. Supported return values are T, error, bool and (T, error).
+// Value returned by middleware is stored into request scope, available to the rest of request handlers
+// of the request, Injector registers value provider for returned value type unless one is registered already.
+// Middleware returning non nil error or false aborts request handling. Panics when middleware returns
+// unsupported values or Injector Routes do not support storing values or aborting request handling
+func (r *Injector) compileMiddlewareResults(fnType reflect.Type) *middlewareResults {
+	if fnType.NumOut() == 0 {
+		return nil
+	}
+
+	errorType := reflect.TypeOf(new(error)).Elem()
+	results := &middlewareResults{valueIndex: -1, abortIndex: -1}
+
+	switch {
+	case fnType.NumOut() == 1 && (fnType.Out(0) == errorType || fnType.Out(0).Kind() == reflect.Bool):
+		results.abortIndex = 0
+	case fnType.NumOut() == 1:
+		results.valueIndex = 0
+	case fnType.NumOut() == 2 && fnType.Out(1) == errorType:
+		results.valueIndex = 0
+		results.abortIndex = 1
+	default:
+		panic(newInvalidMiddlewareResultsError(fnType))
+	}
+
+	if results.abortIndex >= 0 && r.abort == nil {
+		panic(newUnsupportedAbortRoutesError(r.routes, fnType))
+	}
+
+	if results.valueIndex < 0 {
+		return results
+	}
+
+	if r.scopes == nil {
+		panic(newUnsupportedScopeRoutesError(r.routes, fnType))
+	}
+
+	valueType := fnType.Out(results.valueIndex)
+
+	if _, exists := r.provider(valueType); !exists {
+		r.providers[valueType] = newProviderDefinition(valueType, reflect.Value{}, scopeLifetime)
+	}
+
+	results.valueSlot = typeSlots.slot(valueType)
+
+	return results
+}
+
+// apply stores value returned by middleware into request scope, returns false when middleware aborts
+// request handling by returning false or non nil error, along with returned error
+func (p *middlewareResults) apply(scope *Scope, results []reflect.Value) (bool, error) {
+	if p.abortIndex >= 0 {
+		abortResult := results[p.abortIndex]
+
+		if abortResult.Kind() == reflect.Bool && !abortResult.Bool() {
+			return false, nil
+		}
+
+		if abortResult.Kind() != reflect.Bool && !abortResult.IsNil() {
+			return false, abortResult.Interface().(error)
+		}
+	}
+
+	if p.valueIndex >= 0 {
+		scope.set(p.valueSlot, results[p.valueIndex])
+	}
+
+	return true, nil
+}
+
+// RegisterScopeValue registers type T as provided into request scope by middleware calling Scope Provide method,
+// enabling request handlers of the Injector and its groups to inject T. Requests which middleware does not provide
+// T are responded with 500 status code
+func RegisterScopeValue[T any](injector *Injector) {
+	valueType := reflect.TypeOf(new(T)).Elem()
+
+	injector.addProvider(newProviderDefinition(valueType, reflect.Value{}, scopeLifetime))
+}
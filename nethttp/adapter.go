@@ -0,0 +1,188 @@
+package nethttp
+
+import (
+	"context"
+	"github.com/surmus/injection"
+	"net/http"
+	"reflect"
+)
+
+// Context is passed to every request handler registered through the adapter,
+// wraps request context.Context along with request and its response writer.
+// Context holds request scope shared by all request handlers of the request
+type Context struct {
+	context.Context
+	Writer  http.ResponseWriter
+	Request *http.Request
+	scope   *injection.Scope
+	aborted bool
+}
+
+// Abort prevents calling the rest of request handlers of the request
+func (c *Context) Abort() {
+	c.aborted = true
+}
+
+// HandlerFunc is request handler function type used by the adapter
+type HandlerFunc func(*Context)
+
+type adapter struct {
+	mux        *http.ServeMux
+	prefix     string
+	middleware []HandlerFunc
+}
+
+func newAdapter(mux *http.ServeMux) *adapter {
+	return &adapter{mux: mux}
+}
+
+// Adapt enables usage of net/http http.ServeMux with the Injector.
+// returns new instance of Injector from http.ServeMux, request handlers are able to inject
+// http.ResponseWriter, *http.Request and request context.Context values.
+// Routes are registered with http.ServeMux patterns, enabling Go 1.22 wildcards: "/users/{id}"
+// For usages see adapter_test.go file
+func Adapt(mux *http.ServeMux) *injection.Injector {
+	injector, err := injection.NewInjector(newAdapter(mux))
+
+	if err != nil {
+		panic(err)
+	}
+
+	return injector
+}
+
+// AdaptToExisting creates new Injector from existing by copying over all registered value providers from given Injector,
+// otherwise functions similarly to Adapt function
+// For usages see adapter_test.go file
+func AdaptToExisting(existing *injection.Injector, mux *http.ServeMux) *injection.Injector {
+	injector, err := injection.From(existing, newAdapter(mux))
+
+	if err != nil {
+		panic(err)
+	}
+
+	return injector
+}
+
+// Use registers middleware executed before request handlers of every route registered afterwards
+func (r *adapter) Use(handlerFnValues ...reflect.Value) injection.Routes {
+	r.middleware = append(r.middleware, handlerFuncs(handlerFnValues)...)
+
+	return r
+}
+
+func (r *adapter) Handle(httpMethod string, endPoint string, handlerFnValues ...reflect.Value) injection.Routes {
+	handlers := append(append([]HandlerFunc{}, r.middleware...), handlerFuncs(handlerFnValues)...)
+
+	r.mux.HandleFunc(pattern(httpMethod, r.prefix+endPoint), func(w http.ResponseWriter, req *http.Request) {
+		c := &Context{Context: req.Context(), Writer: w, Request: req}
+
+		for _, handler := range handlers {
+			if c.aborted {
+				break
+			}
+
+			handler(c)
+		}
+	})
+
+	return r
+}
+
+// Group returns routes registered under given path prefix, with group middleware executed after adapter middleware
+func (r *adapter) Group(prefix string, handlerFnValues ...reflect.Value) injection.Routes {
+	return &adapter{
+		mux:        r.mux,
+		prefix:     r.prefix + prefix,
+		middleware: append(append([]HandlerFunc{}, r.middleware...), handlerFuncs(handlerFnValues)...),
+	}
+}
+
+func (r *adapter) HandlerFnType() reflect.Type {
+	return reflect.TypeOf(HandlerFunc(nil))
+}
+
+func (r *adapter) Router() (interface{}, string) {
+	return r.mux, r.prefix
+}
+
+func (r *adapter) SeedTypes() []reflect.Type {
+	return []reflect.Type{
+		reflect.TypeOf(new(Context)),
+		reflect.TypeOf(new(http.ResponseWriter)).Elem(),
+		reflect.TypeOf(new(http.Request)),
+		reflect.TypeOf(new(context.Context)).Elem(),
+	}
+}
+
+func (r *adapter) SeedValues(args []reflect.Value) []reflect.Value {
+	c := args[0].Interface().(*Context)
+
+	return []reflect.Value{
+		args[0],
+		reflect.ValueOf(&c.Writer).Elem(),
+		reflect.ValueOf(c.Request),
+		reflect.ValueOf(&c.Context).Elem(),
+	}
+}
+
+func (r *adapter) Param(seeds []reflect.Value, source string, name string) []string {
+	req := seeds[0].Interface().(*Context).Request
+
+	switch source {
+	case injection.PathParam:
+		if value := req.PathValue(name); value != "" {
+			return []string{value}
+		}
+	case injection.QueryParam:
+		return req.URL.Query()[name]
+	case injection.HeaderParam:
+		return req.Header.Values(name)
+	case injection.CookieParam:
+		if cookie, err := req.Cookie(name); err == nil {
+			return []string{cookie.Value}
+		}
+	}
+
+	return nil
+}
+
+func (r *adapter) Scope(seeds []reflect.Value) *injection.Scope {
+	return seeds[0].Interface().(*Context).scope
+}
+
+func (r *adapter) SetScope(seeds []reflect.Value, scope *injection.Scope) []reflect.Value {
+	c := seeds[0].Interface().(*Context)
+	c.scope = scope
+	c.Context = injection.ContextWithScope(c.Context, scope)
+	c.Request = c.Request.WithContext(c.Context)
+
+	return r.SeedValues(seeds[:1])
+}
+
+func (r *adapter) Abort(seeds []reflect.Value) {
+	seeds[0].Interface().(*Context).Abort()
+}
+
+func (r *adapter) ResponseWriter(seeds []reflect.Value) http.ResponseWriter {
+	return seeds[0].Interface().(*Context).Writer
+}
+
+func handlerFuncs(handlerFnValues []reflect.Value) []HandlerFunc {
+	handlers := make([]HandlerFunc, 0, len(handlerFnValues))
+
+	for _, value := range handlerFnValues {
+		handlers = append(handlers, value.Interface().(HandlerFunc))
+	}
+
+	return handlers
+}
+
+// pattern composes http.ServeMux pattern from http method and endpoint path, empty method matches all methods
+func pattern(httpMethod string, endPoint string) string {
+	if httpMethod == "" {
+		return endPoint
+	}
+
+	return httpMethod + " " + endPoint
+}
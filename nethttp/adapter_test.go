@@ -0,0 +1,295 @@
+package nethttp
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/surmus/injection"
+	"github.com/surmus/injection/test"
+	"net/http"
+	"testing"
+)
+
+const ctrlGetEndpoint = "/test-path-get"
+
+type PointerController struct {
+	injection.BaseController
+
+	PrimitiveValConstant string
+
+	Request *http.Request
+
+	valueRequiringContext *test.DependencyStruct
+
+	t *testing.T
+}
+
+func (c *PointerController) Routes() map[string][]string {
+	return map[string][]string{ctrlGetEndpoint: {"GetTest"}}
+}
+
+func (c *PointerController) GetTest(w http.ResponseWriter, ctx context.Context) {
+	assert.NotNil(c.t, c.Request)
+	assert.Equal(c.t, test.Constant, c.PrimitiveValConstant)
+	assert.Equal(c.t, c.Request.Context(), ctx)
+	assert.Equal(c.t, ctx, c.valueRequiringContext.Ctx)
+
+	w.WriteHeader(http.StatusTeapot)
+	w.Write([]byte(test.Response))
+}
+
+func setupMuxWithProviders() (*http.ServeMux, *injection.Injector) {
+	mux := http.NewServeMux()
+	r := Adapt(mux)
+
+	r.RegisterProviders(
+		func(ctx context.Context) *test.DependencyStruct { return &test.DependencyStruct{Ctx: ctx} },
+		func() string { return test.Constant },
+	)
+
+	return mux, r
+}
+
+func TestAdapter_Handle(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"successfully register handler and handle request": func(t *testing.T) {
+			mux, r := setupMuxWithProviders()
+
+			registrationError := r.Handle(http.MethodPost, test.Endpoint, func(
+				w http.ResponseWriter,
+				req *http.Request,
+				ctx context.Context,
+				valueRequiringContext *test.DependencyStruct,
+				providedConstant string,
+			) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, req.Context(), ctx)
+				assert.Equal(t, ctx, valueRequiringContext.Ctx)
+				assert.Equal(t, test.Constant, providedConstant)
+
+				w.WriteHeader(http.StatusTeapot)
+				w.Write([]byte(test.Response))
+			})
+
+			req := test.NewRequest(test.Endpoint, http.MethodPost).
+				MustBuild().Do(mux)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, test.Response, string(req.Response.Body.Bytes()))
+		},
+		"should match request path wildcards": func(t *testing.T) {
+			mux, r := setupMuxWithProviders()
+
+			registrationError := r.Handle(http.MethodGet, "/users/{id}", func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+				w.Write([]byte(req.PathValue("id")))
+			})
+
+			req := test.NewRequest("/users/42", http.MethodGet).
+				MustBuild().Do(mux)
+			notAllowedReq := test.NewRequest("/users/42", http.MethodDelete).
+				MustBuild().Do(mux)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, "42", string(req.Response.Body.Bytes()))
+			assert.Equal(t, http.StatusMethodNotAllowed, notAllowedReq.Response.Code)
+		},
+		"successfully render handler return values": func(t *testing.T) {
+			mux, r := setupMuxWithProviders()
+
+			registrationError := r.Handle(http.MethodGet, "/users/{id}", func(req *http.Request) (int, map[string]string) {
+				return http.StatusTeapot, map[string]string{"id": req.PathValue("id")}
+			})
+
+			req := test.NewRequest("/users/42", http.MethodGet).
+				MustBuild().Do(mux)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.JSONEq(t, `{"id":"42"}`, string(req.Response.Body.Bytes()))
+		},
+		"successfully bind request values into Params struct": func(t *testing.T) {
+			mux, r := setupMuxWithProviders()
+
+			registrationError := r.GET("/users/{id}", func(params *struct {
+				injection.Params
+				ID   int `path:"id"`
+				Page int `query:"page" default:"1"`
+			}) (int, int) {
+				return http.StatusTeapot, params.ID + params.Page
+			})
+
+			req := test.NewRequest("/users/42", http.MethodGet).
+				MustBuild().Do(mux)
+			invalidReq := test.NewRequest("/users/42?page=x", http.MethodGet).
+				MustBuild().Do(mux)
+
+			assert.Nil(t, registrationError)
+			assert.Equal(t, http.StatusTeapot, req.Response.Code)
+			assert.Equal(t, "43", string(req.Response.Body.Bytes()))
+			assert.Equal(t, http.StatusBadRequest, invalidReq.Response.Code)
+		},
+		"fail to register handler with unregistered dependencies": func(t *testing.T) {
+			r := Adapt(http.NewServeMux())
+
+			registrationError := r.Handle(http.MethodGet, test.Endpoint, func(dependency *test.DependencyStruct) {})
+
+			assert.IsType(t, injection.Error{}, registrationError)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestAdapter_Use(t *testing.T) {
+	mux, r := setupMuxWithProviders()
+	var executed []string
+
+	registrationError := r.Use(func(req *http.Request) {
+		executed = append(executed, "middleware")
+	})
+	r.Handle(http.MethodGet, test.Endpoint, func(w http.ResponseWriter) {
+		executed = append(executed, "handler")
+
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	req := test.NewRequest(test.Endpoint, http.MethodGet).
+		MustBuild().Do(mux)
+
+	assert.Nil(t, registrationError)
+	assert.Equal(t, http.StatusTeapot, req.Response.Code)
+	assert.Equal(t, []string{"middleware", "handler"}, executed)
+}
+
+func TestAdapter_SharedScope(t *testing.T) {
+	mux, r := setupMuxWithProviders()
+	var resolved []*test.DependencyStruct
+
+	r.Use(func(dependency *test.DependencyStruct) {
+		resolved = append(resolved, dependency)
+	})
+	r.Handle(http.MethodGet, test.Endpoint, func(w http.ResponseWriter, dependency *test.DependencyStruct) {
+		resolved = append(resolved, dependency)
+
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(mux)
+	test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(mux)
+
+	assert.Len(t, resolved, 4)
+	assert.Same(t, resolved[0], resolved[1])
+	assert.Same(t, resolved[2], resolved[3])
+	assert.NotSame(t, resolved[0], resolved[2])
+}
+
+func TestAdapter_AbortingMiddleware(t *testing.T) {
+	mux, r := setupMuxWithProviders()
+	var executed []string
+
+	r.Use(func(req *http.Request) error {
+		executed = append(executed, "middleware")
+
+		if req.Header.Get("X-Api-Key") != test.Constant {
+			return injection.NewRequestError(http.StatusUnauthorized, errors.New("invalid api key"))
+		}
+
+		return nil
+	})
+	r.Handle(http.MethodGet, test.Endpoint, func(w http.ResponseWriter) {
+		executed = append(executed, "handler")
+
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	req := test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(mux)
+
+	assert.Equal(t, http.StatusUnauthorized, req.Response.Code)
+	assert.Equal(t, []string{"middleware"}, executed)
+
+	req = test.NewRequest(test.Endpoint, http.MethodGet).Header("X-Api-Key", test.Constant).MustBuild().Do(mux)
+
+	assert.Equal(t, http.StatusTeapot, req.Response.Code)
+	assert.Equal(t, []string{"middleware", "middleware", "handler"}, executed)
+}
+
+func TestFromContext(t *testing.T) {
+	mux, r := setupMuxWithProviders()
+	var injected *test.DependencyStruct
+	var fromContext *test.DependencyStruct
+	var constant string
+	var contextErr error
+
+	r.Handle(http.MethodGet, test.Endpoint, func(req *http.Request, dependency *test.DependencyStruct) {
+		injected = dependency
+		fromContext, contextErr = injection.FromContext[*test.DependencyStruct](req.Context())
+		constant, _ = injection.FromContext[string](req.Context())
+	})
+
+	test.NewRequest(test.Endpoint, http.MethodGet).MustBuild().Do(mux)
+
+	assert.Nil(t, contextErr)
+	assert.Same(t, injected, fromContext)
+	assert.Equal(t, test.Constant, constant)
+}
+
+func TestAdapter_RegisterController(t *testing.T) {
+	mux, r := setupMuxWithProviders()
+
+	registrationError := r.RegisterController(&PointerController{PrimitiveValConstant: test.Constant, t: t})
+
+	req := test.NewRequest(ctrlGetEndpoint, http.MethodGet).
+		MustBuild().Do(mux)
+
+	assert.Nil(t, registrationError)
+	assert.Equal(t, http.StatusTeapot, req.Response.Code)
+	assert.Equal(t, test.Response, string(req.Response.Body.Bytes()))
+}
+
+func TestAdaptToExisting(t *testing.T) {
+	_, existing := setupMuxWithProviders()
+	mux := http.NewServeMux()
+
+	r := AdaptToExisting(existing, mux)
+	registrationError := r.Handle(http.MethodGet, test.Endpoint, func(w http.ResponseWriter, providedConstant string) {
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte(providedConstant))
+	})
+
+	req := test.NewRequest(test.Endpoint, http.MethodGet).
+		MustBuild().Do(mux)
+
+	assert.Nil(t, registrationError)
+	assert.Equal(t, test.Constant, string(req.Response.Body.Bytes()))
+}
+
+func TestAdapter_Group(t *testing.T) {
+	mux, r := setupMuxWithProviders()
+	var executed []string
+
+	r.Use(func() {
+		executed = append(executed, "middleware")
+	})
+	group := r.Group("/v1", func(req *http.Request) {
+		executed = append(executed, "group")
+	})
+	registrationError := group.Group("/users").Handle(http.MethodGet, "/{id}", func(w http.ResponseWriter, req *http.Request) {
+		executed = append(executed, "handler")
+
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte(req.PathValue("id")))
+	})
+
+	req := test.NewRequest("/v1/users/42", http.MethodGet).
+		MustBuild().Do(mux)
+
+	assert.Nil(t, registrationError)
+	assert.Equal(t, http.StatusTeapot, req.Response.Code)
+	assert.Equal(t, "42", string(req.Response.Body.Bytes()))
+	assert.Equal(t, []string{"middleware", "group", "handler"}, executed)
+}
@@ -0,0 +1,239 @@
+package injection
+
+import (
+	"encoding"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Params can be embedded into request handler input value struct types, marking the type as bound from request
+// path, query, header and cookie values. Struct fields are bound by tags naming value source and value name,
+// "required" tag option responds with 400 status code when request has no such value,
+// default tag sets value used when request has no such value, example:
+// type ListParams struct { injection.Params; Page int `query:"page" default:"1"`; Key string `header:"X-Key,required"` }
+// Field values are converted from strings into field type, supported field types are strings, booleans, numbers,
+// encoding.TextUnmarshaler implementations and slices of these. Values of Params types are resolved by Routes
+// implementing ParamRoutes interface
+type Params struct{}
+
+// Request value sources of Params struct fields
+const (
+	PathParam   = "path"
+	QueryParam  = "query"
+	HeaderParam = "header"
+	CookieParam = "cookie"
+)
+
+var paramSources = []string{PathParam, QueryParam, HeaderParam, CookieParam}
+
+// ParamRoutes is optional Routes capability for http libraries giving access to request path, query, header
+// and cookie values for resolving Params struct types
+type ParamRoutes interface {
+	// Param returns request values of given source and name from values seeded into request resolution,
+	// returns no values when request does not contain such value
+	Param(seeds []reflect.Value, source string, name string) []string
+}
+
+// paramField is Params struct field bound from request value
+type paramField struct {
+	index        int
+	source       string
+	paramName    string
+	required     bool
+	defaultValue string
+	hasDefault   bool
+}
+
+// isParams reports whether given type is struct or pointer to struct embedding Params
+func isParams(valueType reflect.Type) bool {
+	if valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	if valueType.Kind() != reflect.Struct {
+		return false
+	}
+
+	paramsType := reflect.TypeOf(Params{})
+
+	for i := 0; i < valueType.NumField(); i++ {
+		if field := valueType.Field(i); field.Anonymous && field.Type == paramsType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compileParamFields parses Params struct field tags,
+// panics when field has several value sources or its type cannot be converted from request values
+func compileParamFields(structType reflect.Type) []*paramField {
+	var fields []*paramField
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		field := &paramField{index: i}
+
+		for _, source := range paramSources {
+			tag, exists := structField.Tag.Lookup(source)
+
+			if !exists {
+				continue
+			}
+
+			if field.source != "" {
+				panic(newInvalidParamFieldError(structType, structField.Name, "has several value sources"))
+			}
+
+			tagParts := strings.Split(tag, ",")
+			field.source = source
+			field.paramName = tagParts[0]
+			field.required = len(tagParts) > 1 && tagParts[1] == "required"
+		}
+
+		if field.source == "" {
+			continue
+		}
+
+		if !structField.IsExported() {
+			panic(newInvalidParamFieldError(structType, structField.Name, "is not exported"))
+		}
+
+		if !isConvertibleParam(structField.Type) {
+			panic(newInvalidParamFieldError(structType, structField.Name, "has unsupported type "+structField.Type.String()))
+		}
+
+		field.defaultValue, field.hasDefault = structField.Tag.Lookup("default")
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// paramsBinder returns binder setting Params struct fields from request values with Routes ParamRoutes
+// implementation, request handling is aborted with 400 status code error when required value is missing
+// or value cannot be converted into field type.
+// Panics when Injector Routes do not implement ParamRoutes interface or Params struct fields are invalid
+func (r *Injector) paramsBinder(valueType reflect.Type) binder {
+	paramRoutes, ok := r.routes.(ParamRoutes)
+
+	if !ok {
+		panic(newUnsupportedParamRoutesError(r.routes, valueType))
+	}
+
+	structType := valueType
+
+	if valueType.Kind() == reflect.Ptr {
+		structType = valueType.Elem()
+	}
+
+	fields := compileParamFields(structType)
+
+	return func(target reflect.Value, seeds []reflect.Value, _ []reflect.Value) {
+		for _, field := range fields {
+			values := paramRoutes.Param(seeds, field.source, field.paramName)
+
+			if err := field.bind(target.Elem().Field(field.index), values); err != nil {
+				panic(requestFailure{NewRequestError(http.StatusBadRequest, err)})
+			}
+		}
+	}
+}
+
+// bind sets field value from given request values, default value is used when request has no values
+func (f *paramField) bind(fieldVal reflect.Value, values []string) error {
+	if len(values) == 0 && f.hasDefault {
+		values = []string{f.defaultValue}
+	}
+
+	if len(values) == 0 {
+		if f.required {
+			return fmt.Errorf("missing required %s parameter %q", f.source, f.paramName)
+		}
+
+		return nil
+	}
+
+	if err := convertParam(fieldVal, values); err != nil {
+		return fmt.Errorf("invalid %s parameter %q: %s", f.source, f.paramName, err)
+	}
+
+	return nil
+}
+
+func isConvertibleParam(valueType reflect.Type) bool {
+	if reflect.PtrTo(valueType).Implements(reflect.TypeOf(new(encoding.TextUnmarshaler)).Elem()) {
+		return true
+	}
+
+	switch valueType.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return valueType.Elem().Kind() != reflect.Slice && isConvertibleParam(valueType.Elem())
+	case reflect.Ptr:
+		return isConvertibleParam(valueType.Elem())
+	}
+
+	return false
+}
+
+// convertParam converts request values into given value, all values are used for slice, first value otherwise
+func convertParam(value reflect.Value, values []string) error {
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(values[0]))
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(values[0])
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(values[0])
+		value.SetBool(parsed)
+
+		return conversionError(values[0], value.Type(), err)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(values[0], 10, value.Type().Bits())
+		value.SetInt(parsed)
+
+		return conversionError(values[0], value.Type(), err)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(values[0], 10, value.Type().Bits())
+		value.SetUint(parsed)
+
+		return conversionError(values[0], value.Type(), err)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(values[0], value.Type().Bits())
+		value.SetFloat(parsed)
+
+		return conversionError(values[0], value.Type(), err)
+	case reflect.Ptr:
+		value.Set(reflect.New(value.Type().Elem()))
+
+		return convertParam(value.Elem(), values)
+	case reflect.Slice:
+		value.Set(reflect.MakeSlice(value.Type(), len(values), len(values)))
+
+		for i := range values {
+			if err := convertParam(value.Index(i), values[i:i+1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func conversionError(rawValue string, valueType reflect.Type, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("cannot convert %q to %s", rawValue, valueType)
+}
@@ -0,0 +1,457 @@
+package injection
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+// slotRegistry assigns every type resolved by injectors fixed index(slot) in request scope values slice
+type slotRegistry struct {
+	sync.Mutex
+	slots map[reflect.Type]int
+}
+
+var typeSlots = &slotRegistry{slots: map[reflect.Type]int{}}
+
+func (r *slotRegistry) slot(valueType reflect.Type) int {
+	r.Lock()
+	defer r.Unlock()
+
+	slot, exists := r.slots[valueType]
+
+	if !exists {
+		slot = len(r.slots)
+		r.slots[valueType] = slot
+	}
+
+	return slot
+}
+
+// resolutionStep resolves single value into its request scope slot, resolving provider dependencies first,
+// stage describes the step in errors of failed value resolution
+type resolutionStep struct {
+	slot         int
+	provider     *providerDefinition
+	dependencies []*resolutionStep
+	stage        string
+}
+
+func (s *resolutionStep) resolve(scope *Scope) reflect.Value {
+	if value, resolved := scope.resolved(s.slot, s.provider); resolved {
+		return value
+	}
+
+	defer s.recoverFailure()
+
+	return scope.store(s.slot, s.provider.provide(scope, s.dependencies), s.provider)
+}
+
+// recoverFailure tags resolution failure or provider panic with the step stage,
+// failures already tagged while resolving step dependencies are passed on unchanged
+func (s *resolutionStep) recoverFailure() {
+	e := recover()
+
+	if e == nil {
+		return
+	}
+
+	panic(stageFailure(e, s.stage))
+}
+
+// resolutionPlan is request handler input values resolution compiled at handler registration time,
+// all values resolved during request are stored in request scope by their type slot, size is count of slots
+// the plan resolves. Route describes request handler route in errors of failed value resolution
+type resolutionPlan struct {
+	route     string
+	injector  *Injector
+	scopes    ScopeRoutes
+	seedSlots []int
+	size      int
+	params    []*resolutionStep
+	compiled  map[reflect.Type]*resolutionStep
+}
+
+// scope returns request scope stored on request context by Routes implementing ScopeRoutes,
+// new request scope seeded with values from http library request handler input values
+// when Routes do not implement it or request has no scope stored yet.
+// Values not resolved by the plan are resolved from the scope with value providers of the plan Injector
+func (p *resolutionPlan) scope(seeds []reflect.Value) *Scope {
+	var scope *Scope
+
+	if p.scopes != nil {
+		scope = p.scopes.Scope(seeds)
+	}
+
+	if scope == nil {
+		scope = newScope()
+
+		// seeded request context values change when Routes store request scope on them
+		if p.scopes != nil {
+			seeds = p.scopes.SetScope(seeds, scope)
+		}
+
+		for i, slot := range p.seedSlots {
+			scope.set(slot, seeds[i])
+		}
+	}
+
+	// scope shared by several request handlers is extended with slots resolved by each of them
+	scope.use(p.injector, p.size)
+
+	return scope
+}
+
+// arguments resolves request handler function input values,
+// offset reserves given count of leading values in returned slice for the caller to fill
+func (p *resolutionPlan) arguments(scope *Scope, offset int) []reflect.Value {
+	args := make([]reflect.Value, offset+len(p.params))
+
+	for i, param := range p.params {
+		args[offset+i] = param.resolve(scope)
+	}
+
+	return args
+}
+
+func (p *resolutionPlan) updateSize() {
+	for _, slot := range p.seedSlots {
+		if slot >= p.size {
+			p.size = slot + 1
+		}
+	}
+
+	for _, step := range p.compiled {
+		if step.slot >= p.size {
+			p.size = step.slot + 1
+		}
+	}
+}
+
+// controllerPlan resolves Controller instance and its request handler method input values
+type controllerPlan struct {
+	*resolutionPlan
+	structType   reflect.Type
+	isPtr        bool
+	method       reflect.Value
+	fields       []*fieldResolution
+	instances    *controllerInstances
+	pool         *sync.Pool
+	beforeAction *actionHook
+	afterAction  *actionHook
+}
+
+var errBeforeActionAborted = errors.New("request handling is aborted by BeforeAction")
+
+// actionHook is Controller BeforeAction or AfterAction method called around Controller request handler method,
+// hook method input values are resolved within request handler scope
+type actionHook struct {
+	method reflect.Value
+	params []*resolutionStep
+}
+
+// call calls hook method on given Controller instance,
+// returns false when hook aborts request handling by returning false or non nil error, along with returned error
+func (h *actionHook) call(ctrlVal reflect.Value, scope *Scope) (bool, error) {
+	args := make([]reflect.Value, 1+len(h.params))
+	args[0] = ctrlVal
+
+	for i, param := range h.params {
+		args[1+i] = param.resolve(scope)
+	}
+
+	results := h.method.Call(args)
+
+	if len(results) == 0 {
+		return true, nil
+	}
+
+	if results[0].Kind() == reflect.Bool {
+		return results[0].Bool(), nil
+	}
+
+	if results[0].IsNil() {
+		return true, nil
+	}
+
+	return false, results[0].Interface().(error)
+}
+
+// controllerInstances holds lifetime of registered Controller shared by all its routes,
+// along with the instance of singleton lifetime Controller, which is valid once created without failure
+type controllerInstances struct {
+	lifetime string
+	mutex    sync.Mutex
+	value    reflect.Value
+	valid    bool
+}
+
+func newControllerInstances(lifetime string) *controllerInstances {
+	return &controllerInstances{lifetime: lifetime}
+}
+
+// fieldResolution sets Controller field either from value provider or from static value of registered Controller
+type fieldResolution struct {
+	index       int
+	step        *resolutionStep
+	staticValue reflect.Value
+}
+
+func (p *controllerPlan) controller(scope *Scope) reflect.Value {
+	ctrlPtrVal := reflect.New(p.structType)
+	ctrlVal := ctrlPtrVal.Elem()
+
+	for _, field := range p.fields {
+		value := field.staticValue
+
+		if field.step != nil {
+			value = field.step.resolve(scope)
+		}
+
+		unsafeFieldElem(ctrlVal, field.index).Set(value)
+	}
+
+	return p.receiver(ctrlPtrVal)
+}
+
+// singletonController returns singleton lifetime Controller instance shared by all its routes,
+// instance failing to be created is created again by next request
+func (p *controllerPlan) singletonController(scope *Scope) reflect.Value {
+	p.instances.mutex.Lock()
+	defer p.instances.mutex.Unlock()
+
+	if !p.instances.valid {
+		p.instances.value = p.controller(scope)
+		p.instances.valid = true
+	}
+
+	return p.instances.value
+}
+
+func (p *controllerPlan) receiver(ctrlPtrVal reflect.Value) reflect.Value {
+	if p.isPtr {
+		return ctrlPtrVal
+	}
+
+	return ctrlPtrVal.Elem()
+}
+
+// newPooledController creates Controller instance for the pool, only static field values are set
+func (p *controllerPlan) newPooledController() interface{} {
+	ctrlPtrVal := reflect.New(p.structType)
+	p.resetPooledController(ctrlPtrVal)
+
+	return ctrlPtrVal.Interface()
+}
+
+// resetPooledController resets pooled Controller instance to zero value holding only static field values,
+// dropping injected values along with any state request handling left on the instance
+func (p *controllerPlan) resetPooledController(ctrlPtrVal reflect.Value) {
+	ctrlPtrVal.Elem().Set(reflect.Zero(p.structType))
+
+	for _, field := range p.fields {
+		if field.step == nil {
+			unsafeFieldElem(ctrlPtrVal.Elem(), field.index).Set(field.staticValue)
+		}
+	}
+}
+
+// setInjectedFields sets Controller fields injected from value providers
+func (p *controllerPlan) setInjectedFields(ctrlVal reflect.Value, scope *Scope) {
+	for _, field := range p.fields {
+		if field.step != nil {
+			unsafeFieldElem(ctrlVal, field.index).Set(field.step.resolve(scope))
+		}
+	}
+}
+
+// call calls Controller request handler method, returns request handler method return values,
+// nil return values along with error when BeforeAction hook aborts request handling
+func (p *controllerPlan) call(seeds []reflect.Value) ([]reflect.Value, error) {
+	scope := p.scope(seeds)
+
+	switch p.instances.lifetime {
+	case pooledLifetime:
+		ctrlPtrVal := reflect.ValueOf(p.pool.Get())
+		p.setInjectedFields(ctrlPtrVal.Elem(), scope)
+
+		results, err := p.callAction(p.receiver(ctrlPtrVal), scope)
+
+		p.resetPooledController(ctrlPtrVal)
+		p.pool.Put(ctrlPtrVal.Interface())
+
+		return results, err
+	case singletonLifetime:
+		return p.callAction(p.singletonController(scope), scope)
+	default:
+		return p.callAction(p.controller(scope), scope)
+	}
+}
+
+// callAction calls request handler method on given Controller instance between Controller action hooks,
+// request handler method input values are resolved after BeforeAction hook, which may abort request handling.
+// Returns error returned by BeforeAction hook, or forbidden RequestError when the hook returns false
+func (p *controllerPlan) callAction(ctrlVal reflect.Value, scope *Scope) ([]reflect.Value, error) {
+	if p.beforeAction != nil {
+		if proceed, err := p.beforeAction.call(ctrlVal, scope); !proceed {
+			if err == nil {
+				err = NewRequestError(http.StatusForbidden, errBeforeActionAborted)
+			}
+
+			return nil, err
+		}
+	}
+
+	// first method func param is receiver
+	args := p.arguments(scope, 1)
+	args[0] = ctrlVal
+
+	results := p.method.Call(args)
+
+	if p.afterAction != nil {
+		p.afterAction.call(ctrlVal, scope)
+	}
+
+	return results, nil
+}
+
+func (r *Injector) compilePlan(paramTypes []reflect.Type, route string) *resolutionPlan {
+	plan := &resolutionPlan{
+		route:    route,
+		injector: r,
+		scopes:   r.scopes,
+		compiled: make(map[reflect.Type]*resolutionStep),
+	}
+
+	for _, seedType := range r.seedTypes {
+		plan.seedSlots = append(plan.seedSlots, typeSlots.slot(seedType))
+	}
+
+	for _, paramType := range paramTypes {
+		plan.params = append(plan.params, r.compileStep(plan, paramType, make(map[reflect.Type]bool)))
+	}
+
+	plan.updateSize()
+
+	return plan
+}
+
+// compileStep composes resolution step for given type from currently registered value providers,
+// panics when type or any of its dependencies has no registered value provider
+func (r *Injector) compileStep(plan *resolutionPlan, valueType reflect.Type, compiling map[reflect.Type]bool) *resolutionStep {
+	if step, compiled := plan.compiled[valueType]; compiled {
+		return step
+	}
+
+	if compiling[valueType] {
+		panic(newCyclicDependencyError(valueType))
+	}
+
+	compiling[valueType] = true
+	provider := r.registeredProvider(valueType)
+
+	if provider.paramName != "" {
+		r.checkRouteParam(plan.route, provider)
+	}
+
+	step := &resolutionStep{
+		slot:     typeSlots.slot(valueType),
+		provider: provider,
+		stage:    fmt.Sprintf("resolving %s for %s", valueType, plan.route),
+	}
+
+	for _, dependencyType := range provider.dependencies() {
+		step.dependencies = append(step.dependencies, r.compileStep(plan, dependencyType, compiling))
+	}
+
+	plan.compiled[valueType] = step
+
+	return step
+}
+
+func (r *Injector) compileControllerPlan(
+	ctrlVal reflect.Value,
+	handlerMethod reflect.Method,
+	instances *controllerInstances,
+	route string,
+) *controllerPlan {
+	plan := &controllerPlan{
+		resolutionPlan: r.compilePlan(fnParamTypes(handlerMethod.Type, 1), route),
+		structType:     ctrlVal.Type(),
+		method:         handlerMethod.Func,
+		instances:      instances,
+	}
+	plan.beforeAction = r.compileActionHook(plan.resolutionPlan, ctrlVal.Type(), beforeActionMethod, true)
+	plan.afterAction = r.compileActionHook(plan.resolutionPlan, ctrlVal.Type(), afterActionMethod, false)
+
+	// action hooks commonly keep request state on Controller instance, which singleton Controller shares between requests
+	if instances.lifetime == singletonLifetime && (plan.beforeAction != nil || plan.afterAction != nil) {
+		panic(newSingletonControllerActionHookError(ctrlVal.Type()))
+	}
+
+	if ctrlVal.Kind() == reflect.Ptr {
+		plan.isPtr = true
+		plan.structType = ctrlVal.Type().Elem()
+		ctrlVal = ctrlVal.Elem()
+	} else {
+		ctrlVal = addressableCpy(ctrlVal)
+	}
+
+	for i := 0; i < ctrlVal.NumField(); i++ {
+		fieldVal := unsafeFieldElem(ctrlVal, i)
+
+		if isNilValue(fieldVal) {
+			step := r.compileStep(plan.resolutionPlan, fieldVal.Type(), make(map[reflect.Type]bool))
+			plan.fields = append(plan.fields, &fieldResolution{index: i, step: step})
+
+			if instances.lifetime == singletonLifetime && step.provider.lifetime != singletonLifetime {
+				panic(newNonSingletonControllerFieldError(plan.structType, fieldVal.Type()))
+			}
+
+			continue
+		}
+
+		plan.fields = append(plan.fields, &fieldResolution{index: i, staticValue: fieldVal})
+	}
+
+	plan.updateSize()
+
+	if instances.lifetime == pooledLifetime {
+		plan.pool = &sync.Pool{New: plan.newPooledController}
+	}
+
+	return plan
+}
+
+// compileActionHook compiles Controller action hook method when Controller has one,
+// panics when hook method signature returns values other than single bool or error value allowed for aborting hooks
+func (r *Injector) compileActionHook(
+	plan *resolutionPlan,
+	ctrlType reflect.Type,
+	methodName string,
+	canAbort bool,
+) *actionHook {
+	method, exists := ctrlType.MethodByName(methodName)
+
+	if !exists {
+		return nil
+	}
+
+	errorType := reflect.TypeOf(new(error)).Elem()
+	methodType := method.Type
+
+	if methodType.NumOut() > 1 || methodType.NumOut() == 1 &&
+		(!canAbort || methodType.Out(0).Kind() != reflect.Bool && methodType.Out(0) != errorType) {
+		panic(newInvalidActionHookError(ctrlType, methodName))
+	}
+
+	hook := &actionHook{method: method.Func}
+
+	for _, paramType := range fnParamTypes(methodType, 1) {
+		hook.params = append(hook.params, r.compileStep(plan, paramType, make(map[reflect.Type]bool)))
+	}
+
+	return hook
+}
@@ -0,0 +1,176 @@
+package injection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime/debug"
+)
+
+// middlewareRoute describes Use and Group middleware, which is not bound to single route, in request errors
+const middlewareRoute = "middleware"
+
+// routeName describes route handled with given http methods in request errors
+func routeName(httpMethods []string, endPoint string) string {
+	if len(httpMethods) == 1 {
+		return httpMethods[0] + " " + endPoint
+	}
+
+	return "ANY " + endPoint
+}
+
+// StageError tags request handling error with request handling stage it happened at,
+// for example "resolving *sql.DB for GET /users". Errors passed to Injector error handler are StageError values,
+// Stack holds stack trace of recovered panic and is empty for errors returned by request handlers and providers
+type StageError struct {
+	Stage string
+	Err   error
+	Stack []byte
+}
+
+// Error returns request handling stage followed by wrapped error string representation
+func (e *StageError) Error() string {
+	return e.Stage + ": " + e.Err.Error()
+}
+
+// Unwrap returns wrapped error
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// requestFailure aborts request handling from within value resolution, recovered by request handler
+type requestFailure struct {
+	err error
+}
+
+// problemDetails is RFC 7807 problem details document written by default error handler,
+// Errors lists field errors of ValidationError
+type problemDetails struct {
+	Type   string       `json:"type"`
+	Title  string       `json:"title"`
+	Status int          `json:"status"`
+	Detail string       `json:"detail,omitempty"`
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// errorHandler is request handling error handler set with Injector OnError method,
+// seedIndex is index of handler context parameter within values seeded into request resolution
+type errorHandler struct {
+	fn        reflect.Value
+	seedIndex int
+}
+
+// OnError sets function handling request handling errors of the Injector and all its groups,
+// handler has signature func(ctx, error) where ctx is any of Routes seed types, for example *gin.Context.
+// Handler receives errors returned by request handlers, value resolution failures and recovered panics
+// tagged with request handling stage as StageError. By default errors are written as RFC 7807
+// problem details documents. Returns error when handler signature is invalid
+func (r *Injector) OnError(handler interface{}) error {
+	handlerVal := reflect.ValueOf(handler)
+	errorType := reflect.TypeOf(new(error)).Elem()
+
+	if handlerVal.Kind() != reflect.Func || handlerVal.Type().NumIn() != 2 || handlerVal.Type().NumOut() != 0 ||
+		handlerVal.Type().In(1) != errorType {
+		return newInvalidErrorHandlerError(handler, r.seedTypes)
+	}
+
+	for i, seedType := range r.seedTypes {
+		if handlerVal.Type().In(0) == seedType {
+			r.root().errorHandler = &errorHandler{fn: handlerVal, seedIndex: i}
+			return nil
+		}
+	}
+
+	return newInvalidErrorHandlerError(handler, r.seedTypes)
+}
+
+// recoverRequestFailure passes error of request handling aborted by value resolution failure or panic
+// to Injector error handler, errors not tagged with request handling stage are tagged as handling given route.
+// Rest of request handlers of the request are not called when Injector Routes support aborting request handling
+func (r *Injector) recoverRequestFailure(seeds []reflect.Value, route string) {
+	e := recover()
+
+	if e == nil {
+		return
+	}
+
+	failure := stageFailure(e, "handling "+route)
+
+	if r.abort != nil {
+		r.abort(seeds)
+	}
+
+	r.handleError(seeds, failure.err)
+}
+
+// stageFailure converts value recovered at given request handling stage into request failure tagged with the stage,
+// failures already tagged are returned unchanged. Recovered http.ErrAbortHandler is panicked again,
+// so that http server aborts the response
+func stageFailure(e interface{}, stage string) requestFailure {
+	if e == http.ErrAbortHandler {
+		panic(e)
+	}
+
+	failure, ok := e.(requestFailure)
+
+	if !ok {
+		return requestFailure{&StageError{Stage: stage, Err: panicError(e), Stack: debug.Stack()}}
+	}
+
+	if _, tagged := failure.err.(*StageError); !tagged {
+		failure.err = &StageError{Stage: stage, Err: failure.err}
+	}
+
+	return failure
+}
+
+// panicError converts recovered panic value into error
+func panicError(e interface{}) error {
+	if err, ok := e.(error); ok {
+		return fmt.Errorf("panic: %w", err)
+	}
+
+	return fmt.Errorf("panic: %v", e)
+}
+
+// handleError passes given request handling error to error handler set with OnError method,
+// by default writes problem details response. Errors are dropped when Injector Routes implementation
+// does not give access to http response
+func (r *Injector) handleError(seeds []reflect.Value, err error) {
+	if handler := r.root().errorHandler; handler != nil {
+		handler.fn.Call([]reflect.Value{seeds[handler.seedIndex], reflect.ValueOf(&err).Elem()})
+		return
+	}
+
+	if r.responseWriter == nil {
+		return
+	}
+
+	problem := problemDetails{Type: "about:blank", Status: http.StatusInternalServerError}
+
+	var requestErr *RequestError
+	var validationErr *ValidationError
+
+	// client error details describe invalid request, server error details are not exposed
+	switch {
+	case errors.As(err, &validationErr):
+		problem.Status = http.StatusUnprocessableEntity
+		problem.Errors = validationErr.Errors
+	case errors.As(err, &requestErr):
+		problem.Status = requestErr.Status
+
+		if problem.Status < http.StatusInternalServerError {
+			problem.Detail = requestErr.Error()
+		}
+	}
+
+	problem.Title = http.StatusText(problem.Status)
+	body, _ := json.Marshal(problem)
+	writer := r.responseWriter(seeds)
+
+	writer.Header().Set("Content-Type", "application/problem+json")
+	writer.WriteHeader(problem.Status)
+	_, _ = writer.Write(body)
+}
@@ -0,0 +1,103 @@
+package injection
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+)
+
+// Renderer writes request handler return value into http response, used for rendering return values of
+// request handler functions and Controller request handler methods. Set with Injector SetRenderer method
+type Renderer interface {
+	// Render writes given value into http response with given status code
+	Render(writer http.ResponseWriter, status int, value interface{}) error
+}
+
+// jsonRenderer is default Renderer writing values as JSON documents
+type jsonRenderer struct{}
+
+func (jsonRenderer) Render(writer http.ResponseWriter, status int, value interface{}) error {
+	body, err := json.Marshal(value)
+
+	if err != nil {
+		return err
+	}
+
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.WriteHeader(status)
+	_, err = writer.Write(body)
+
+	return err
+}
+
+// resultsPlan is request handler return values rendering compiled at handler registration time,
+// indexes of return values not returned by the handler are -1
+type resultsPlan struct {
+	route       string
+	statusIndex int
+	valueIndex  int
+	errorIndex  int
+}
+
+// compileResults compiles rendering of given request handler function return values,
+// returns nil when handler returns no values. Supported return values are T, error, (T, error) and (int, T),
+// where int is http response status code. Panics when handler returns unsupported values
+// or Injector Routes implementation does not give access to http response
+func (r *Injector) compileResults(fnType reflect.Type, route string) *resultsPlan {
+	if fnType.NumOut() == 0 {
+		return nil
+	}
+
+	if r.responseWriter == nil {
+		panic(newUnsupportedResponseRoutesError(r.routes, fnType))
+	}
+
+	errorType := reflect.TypeOf(new(error)).Elem()
+	plan := &resultsPlan{route: route, statusIndex: -1, valueIndex: -1, errorIndex: -1}
+
+	switch {
+	case fnType.NumOut() == 1 && fnType.Out(0) == errorType:
+		plan.errorIndex = 0
+	case fnType.NumOut() == 1:
+		plan.valueIndex = 0
+	case fnType.NumOut() == 2 && fnType.Out(1) == errorType:
+		plan.valueIndex = 0
+		plan.errorIndex = 1
+	case fnType.NumOut() == 2 && fnType.Out(0).Kind() == reflect.Int:
+		plan.statusIndex = 0
+		plan.valueIndex = 1
+	default:
+		panic(newInvalidHandlerResultsError(fnType))
+	}
+
+	return plan
+}
+
+// render writes given request handler return values into http response with Injector Renderer,
+// returned error and error returned by the Renderer are passed to Injector error handler
+func (p *resultsPlan) render(r *Injector, seeds []reflect.Value, results []reflect.Value) {
+	if p.errorIndex >= 0 && !results[p.errorIndex].IsNil() {
+		r.handleError(seeds, &StageError{Stage: "handling " + p.route, Err: results[p.errorIndex].Interface().(error)})
+		return
+	}
+
+	if p.valueIndex < 0 {
+		return
+	}
+
+	status := http.StatusOK
+
+	if p.statusIndex >= 0 {
+		status = int(results[p.statusIndex].Int())
+	}
+
+	if err := r.root().renderer.Render(r.responseWriter(seeds), status, results[p.valueIndex].Interface()); err != nil {
+		r.handleError(seeds, &StageError{Stage: "rendering result for " + p.route, Err: err})
+	}
+}
+
+// SetRenderer sets Renderer used for rendering request handler return values of the Injector and all its groups,
+// by default return values are written as JSON documents
+func (r *Injector) SetRenderer(renderer Renderer) {
+	r.root().renderer = renderer
+}
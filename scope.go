@@ -0,0 +1,158 @@
+package injection
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Scope holds values resolved within single request, values are stored by their type slot along with value provider
+// which resolved them. Request scope is shared by all middleware and request handlers of the request
+// when Injector Routes implement ScopeRoutes interface. Request handlers can inject *Scope,
+// code without access to injection can get it with ScopeFromContext function.
+// Scope is safe for use by goroutines started by request handlers, value resolved by several goroutines at once
+// may be provided more than once, all of them receive the value stored first
+type Scope struct {
+	mutex     sync.Mutex
+	values    []reflect.Value
+	providers []*providerDefinition
+	injector  *Injector
+}
+
+var scopeType = reflect.TypeOf(new(Scope))
+
+// ScopeRoutes is optional Routes capability for http libraries storing request scope on request context,
+// without it every middleware and request handler of the request resolves its values into new request scope
+type ScopeRoutes interface {
+	// Scope returns request scope stored on request context from values seeded into request resolution,
+	// returns nil when request has no scope stored yet
+	Scope(seeds []reflect.Value) *Scope
+
+	// SetScope stores request scope on request context from values seeded into request resolution,
+	// request scope should also be stored on request context.Context with ContextWithScope function.
+	// Returns values seeded into request resolution holding updated request context
+	SetScope(seeds []reflect.Value, scope *Scope) []reflect.Value
+}
+
+// newScope creates request scope holding only the scope itself
+func newScope() *Scope {
+	scope := &Scope{}
+	scope.set(typeSlots.slot(scopeType), reflect.ValueOf(scope))
+
+	return scope
+}
+
+// Provide stores given value into request scope by its dynamic type, overriding value of the type resolved already.
+// Provided value is available to the rest of request handlers of the request injecting the type,
+// types not provided by any value provider should be registered with RegisterScopeValue function.
+// Value of interface type is stored by its concrete type, Provide function stores values by interface type
+func (s *Scope) Provide(value interface{}) {
+	s.set(typeSlots.slot(reflect.TypeOf(value)), reflect.ValueOf(value))
+}
+
+// Provide stores given value into request scope by type T, example: injection.Provide[Authenticator](scope, auth)
+// makes auth available to request handlers injecting Authenticator interface
+func Provide[T any](s *Scope, value T) {
+	valueType := reflect.TypeOf(new(T)).Elem()
+
+	s.set(typeSlots.slot(valueType), reflect.ValueOf(&value).Elem())
+}
+
+// resolve returns value of given type from the scope, value not resolved yet is resolved with value providers
+// of Injector which request handler used the scope last. Returns error when value cannot be resolved
+func (s *Scope) resolve(valueType reflect.Type) (value reflect.Value, err error) {
+	slot := typeSlots.slot(valueType)
+
+	s.mutex.Lock()
+	injector := s.injector
+
+	if slot < len(s.values) && s.values[slot].IsValid() {
+		value = s.values[slot]
+	}
+
+	s.mutex.Unlock()
+
+	if value.IsValid() {
+		return value, nil
+	}
+
+	if injector == nil {
+		return value, newUnknownProviderRequestError(valueType)
+	}
+
+	step, size, err := injector.contextStep(valueType)
+
+	if err != nil {
+		return value, err
+	}
+
+	defer func() {
+		if failure, ok := recover().(requestFailure); ok {
+			err = failure.err
+		}
+	}()
+
+	s.grow(size)
+
+	return step.resolve(s), nil
+}
+
+// use prepares the scope for resolving values of request handler of given Injector, extending it to given slot count
+func (s *Scope) use(injector *Injector, size int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.extend(size)
+	s.injector = injector
+}
+
+// set stores given value into given slot, values stored without value provider are injected by all request handlers
+func (s *Scope) set(slot int, value reflect.Value) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.extend(slot + 1)
+	s.values[slot] = value
+	s.providers[slot] = nil
+}
+
+// resolved returns value of given slot when it is stored without value provider or resolved by given provider,
+// value resolved by provider of another Injector group is resolved again for request handlers of the group
+func (s *Scope) resolved(slot int, provider *providerDefinition) (reflect.Value, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	value := s.values[slot]
+
+	return value, value.IsValid() && (s.providers[slot] == nil || s.providers[slot] == provider)
+}
+
+// store stores given value resolved by given value provider into given slot, returns value stored into the slot
+// by the provider meanwhile by another goroutine instead
+func (s *Scope) store(slot int, value reflect.Value, provider *providerDefinition) reflect.Value {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if stored := s.values[slot]; stored.IsValid() && s.providers[slot] == provider {
+		return stored
+	}
+
+	s.values[slot] = value
+	s.providers[slot] = provider
+
+	return value
+}
+
+// grow extends scope to hold at least given count of slots
+func (s *Scope) grow(size int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.extend(size)
+}
+
+func (s *Scope) extend(size int) {
+	if len(s.values) < size {
+		s.values = append(s.values, make([]reflect.Value, size-len(s.values))...)
+		s.providers = append(s.providers, make([]*providerDefinition, size-len(s.providers))...)
+	}
+}
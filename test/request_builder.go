@@ -29,6 +29,13 @@ func (builder *RequestBuilder) JsonContent(value interface{}) *RequestBuilder {
 	return builder
 }
 
+// Body sets raw http request body of the request being built
+func (builder *RequestBuilder) Body(content string) *RequestBuilder {
+	builder.content = []byte(content)
+
+	return builder
+}
+
 // Header add/replaces http request header to the request being built
 func (builder *RequestBuilder) Header(name string, value string) *RequestBuilder {
 	builder.headers[name] = value
@@ -1,7 +1,10 @@
 package injection
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 )
 
 // Provider should be function returning one value, used for registering value providers with Injector RegisterProviders method.
@@ -22,14 +25,6 @@ func NewSingletonProvider(provider Provider) *singletonProvider {
 // See injector_test.go file for examples
 type Handler interface{}
 
-type registeredProvider func(ctxValues resolvedValues) interface{}
-
-func staticValueRegisterProvider(value reflect.Value) registeredProvider {
-	return func(ctxValues resolvedValues) interface{} {
-		return value.Interface()
-	}
-}
-
 // Controller should contain type methods which are used for http request handling
 // request handler methods should be exported(start with capital character)
 // request handler http method is determined from the first part of the method name,
@@ -44,12 +39,15 @@ func staticValueRegisterProvider(value reflect.Value) registeredProvider {
 // - OptionsMethodName - handles OPTIONS request
 // - PatchMethodName - handles PATCH request
 // - TraceMethodName - handles TRACE request
-// when method name does not match any known http method, GET is used
+// when method name does not match any known http method, GET is used, unless Injector uses strict routes
+// http method can also be given explicitly with route spec, in which case method name is not used
 // See injector_test.go file for examples
 type Controller interface {
 	// Routes should return mapping of http route endpoint / Controller methods
 	// example: map[string][]string{"/test-http-path": {"PostMethodName"}}
-	// "/test-http-path" being http request endpoint and "PostMethodName" as request handler for POST http method
+	// "/test-http-path" being http request endpoint and "PostMethodName" as request handler for POST http method.
+	// Route spec of http method and endpoint can be used in place of endpoint:
+	// map[string][]string{"POST /articles/:id/publish": {"PublishArticle"}}
 	Routes() map[string][]string
 
 	// Middleware is used to tell injector which methods use middleware in their request handling chain
@@ -58,6 +56,17 @@ type Controller interface {
 	Middleware() map[string][]Handler
 }
 
+// BasePathController is optional Controller interface, base path prefixes every Controller route endpoint
+type BasePathController interface {
+	BasePath() string
+}
+
+// MiddlewareController is optional Controller interface for middleware shared by all Controller routes,
+// Controller middleware is executed before middleware returned by Controller Middleware method
+type MiddlewareController interface {
+	ControllerMiddleware() []Handler
+}
+
 // BaseController can be embedded into Controller implementations in order to skip implementing Middleware interface method
 type BaseController struct{}
 
@@ -66,18 +75,280 @@ func (c *BaseController) Middleware() map[string][]Handler {
 	return make(map[string][]Handler)
 }
 
-type resolvedValues map[reflect.Type]reflect.Value
+// ControllerRoute is Controller route declared with Controller request handler method expression,
+// enabling compiler to verify request handler method existence. Use Route function for creation
+type ControllerRoute struct {
+	httpMethod string
+	endPoint   string
+	method     reflect.Value
+	middleware []Handler
+}
+
+// Route creates ControllerRoute for RegisterController method from Controller method expression,
+// given middleware is executed after middleware returned by Controller Middleware method for the request handler method.
+// example: injection.Route(http.MethodPost, "/users", (*UserController).PostCreate)
+func Route(httpMethod string, endPoint string, method interface{}, middleware ...Handler) *ControllerRoute {
+	return &ControllerRoute{
+		httpMethod: httpMethod,
+		endPoint:   endPoint,
+		method:     funcValueOf(method),
+		middleware: middleware,
+	}
+}
+
+type pooledController struct {
+	Controller
+}
+
+// NewPooledController instructs the Injector to reuse given Controller instances between requests through sync.Pool.
+// Controller fields injected from value providers are set on every request, after request is handled
+// Controller instance is reset to zero value holding static field values copied from given Controller
+func NewPooledController(controller Controller) Controller {
+	return &pooledController{Controller: controller}
+}
+
+type singletonController struct {
+	Controller
+}
+
+// NewSingletonController instructs the Injector to resolve given Controller instance only once,
+// all successive requests are handled by the instance resolved at the first time.
+// All Controller fields injected from value providers should be provided by singleton providers,
+// Controller can not have BeforeAction or AfterAction methods
+func NewSingletonController(controller Controller) Controller {
+	return &singletonController{Controller: controller}
+}
+
+// Controller action hook method names, Controller methods with these names are called around every request handler method:
+// - BeforeAction is called before request handler method, request handling is aborted when it returns false or error,
+// false is responded with 403 Forbidden status
+// - AfterAction is called after request handler method
+// hook methods receive injected input values, same as request handler methods. Singleton Controllers can not have hooks
+const (
+	beforeActionMethod = "BeforeAction"
+	afterActionMethod  = "AfterAction"
+)
+
+const (
+	requestLifetime   = "request"
+	singletonLifetime = "singleton"
+	contextLifetime   = "context"
+	pooledLifetime    = "pooled"
+	bindingLifetime   = "binding"
+	scopeLifetime     = "scope"
+)
+
+// providerDefinition is registered value provider, singleton lifetime provider holds its resolved value,
+// valid is set once singleton provider function returns normally
+type providerDefinition struct {
+	kind      reflect.Type
+	fn        reflect.Value
+	lifetime  string
+	singleton sync.Mutex
+	value     reflect.Value
+	valid     bool
+	// source is registered function called by fn, Injector manifest describes it instead of fn
+	source reflect.Value
+	// paramName is route path parameter route binding provider resolves value from
+	paramName string
+	// synthesized provider is composed by Injector for type bound from request, it has no source location
+	synthesized bool
+}
+
+func newProviderDefinition(kind reflect.Type, fn reflect.Value, lifetime string) *providerDefinition {
+	return &providerDefinition{kind: kind, fn: fn, lifetime: lifetime}
+}
+
+func (d *providerDefinition) dependencies() []reflect.Type {
+	var dependencies []reflect.Type
 
-type typedProvider struct {
-	kind  reflect.Type
-	value registeredProvider
+	if !d.fn.IsValid() {
+		return dependencies
+	}
+
+	for i := 0; i < d.fn.Type().NumIn(); i++ {
+		dependencies = append(dependencies, d.fn.Type().In(i))
+	}
+
+	return dependencies
 }
 
-func newTypedProvider(providerType reflect.Type, provider registeredProvider) *typedProvider {
-	return &typedProvider{kind: providerType, value: provider}
+// provide calls provider function with dependencies resolved into request scope,
+// singleton lifetime provider function is called until it returns normally, failed calls are retried by next request.
+// Aborts request handling when value provided into request scope by middleware is missing
+func (d *providerDefinition) provide(scope *Scope, dependencies []*resolutionStep) reflect.Value {
+	// values provided into request scope by middleware have no provider function
+	if d.lifetime == scopeLifetime {
+		panic(requestFailure{newUnprovidedScopeValueError(d.kind)})
+	}
+
+	if d.lifetime != singletonLifetime {
+		return d.call(scope, dependencies)
+	}
+
+	d.singleton.Lock()
+	defer d.singleton.Unlock()
+
+	if !d.valid {
+		d.value = d.call(scope, dependencies)
+		d.valid = true
+	}
+
+	return d.value
+}
+
+func (d *providerDefinition) call(scope *Scope, dependencies []*resolutionStep) reflect.Value {
+	args := make([]reflect.Value, len(dependencies))
+
+	for i, dependency := range dependencies {
+		args[i] = dependency.resolve(scope)
+	}
+
+	return d.fn.Call(args)[0]
+}
+
+// routeDefinition describes http route registered with Injector, used for composing Injector Manifest
+// and detecting conflicting routes
+type routeDefinition struct {
+	httpMethod string
+	endPoint   string
+	handlers   []*handlerDefinition
+}
+
+// source describes route request handler along with its source location
+func (d *routeDefinition) source() string {
+	if len(d.handlers) == 0 {
+		return "no request handler"
+	}
+
+	handler := d.handlers[len(d.handlers)-1]
+
+	return fmt.Sprintf("%s (%s)", handler.name, funcSource(handler.fn))
+}
+
+// routeTable records routes reserved by injectors registering routes into same router,
+// router is nil when Injector Routes do not implement RouterRoutes
+type routeTable struct {
+	router interface{}
+	routes []*reservedRoute
+}
+
+// reserve records given routes, returns error naming both conflicting routes sources when any of given routes
+// conflicts with recorded route or another given route, none of given routes are recorded in that case
+func (t *routeTable) reserve(routes []*reservedRoute) error {
+	for i, route := range routes {
+		for _, existing := range append(append([]*reservedRoute{}, t.routes...), routes[:i]...) {
+			if route.conflicts(existing) {
+				return newRouteConflictError(route.definition, existing.definition)
+			}
+		}
+	}
+
+	t.routes = append(t.routes, routes...)
+
+	return nil
+}
+
+// reservedRoute is route registered under path prefix of Routes implementing RouterRoutes
+type reservedRoute struct {
+	definition *routeDefinition
+	prefix     string
+}
+
+// conflicts reports whether routes have same http method and path with path parameter names omitted,
+// as routes differing only by path parameter names conflict: "/users/:id" and "/users/:name".
+// Routes naming wildcard of same path position differently also conflict: "/users/:id" and "/users/:name/posts"
+func (r *reservedRoute) conflicts(other *reservedRoute) bool {
+	if r.definition.httpMethod != other.definition.httpMethod {
+		return false
+	}
+
+	segments := r.segments()
+	otherSegments := other.segments()
+
+	if strings.Join(normalizedSegments(segments), "/") == strings.Join(normalizedSegments(otherSegments), "/") {
+		return true
+	}
+
+	for i := 0; i < len(segments) && i < len(otherSegments); i++ {
+		if segments[i] != otherSegments[i] {
+			return isWildcardSegment(segments[i]) && isWildcardSegment(otherSegments[i])
+		}
+	}
+
+	return false
+}
+
+func (r *reservedRoute) segments() []string {
+	return strings.Split(r.prefix+r.definition.endPoint, "/")
+}
+
+// normalizedSegments returns route path segments with path parameter names omitted
+func normalizedSegments(segments []string) []string {
+	normalized := make([]string, len(segments))
+
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			normalized[i] = ":"
+		case strings.HasPrefix(segment, "*"):
+			normalized[i] = "*"
+		case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "...}"):
+			normalized[i] = "{...}"
+		case strings.HasPrefix(segment, "{"):
+			normalized[i] = "{}"
+		default:
+			normalized[i] = segment
+		}
+	}
+
+	return normalized
+}
+
+// segmentParamName returns name of path parameter given route path segment declares, empty for static segments
+func segmentParamName(segment string) string {
+	switch {
+	case isWildcardSegment(segment):
+		return segment[1:]
+	case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}"):
+		return strings.TrimSuffix(segment[1:len(segment)-1], "...")
+	}
+
+	return ""
+}
+
+// isWildcardSegment reports whether path segment is named wildcard of routers keeping single wildcard
+// per path position, such as gin: ":id" or "*path"
+func isWildcardSegment(segment string) bool {
+	return strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*")
+}
+
+// handlerDefinition describes http request handler or middleware function registered with Injector
+type handlerDefinition struct {
+	fn     reflect.Value
+	name   string
+	params []reflect.Type
+	fields []reflect.Type
+}
+
+func newHandlerDefinition(handler Handler) *handlerDefinition {
+	handlerValue := reflect.ValueOf(handler)
+
+	return &handlerDefinition{fn: handlerValue, name: funcName(handlerValue), params: fnParamTypes(handlerValue.Type(), 0)}
+}
+
+func newControllerHandlerDefinition(ctrlVal reflect.Value, handlerMethod reflect.Method) *handlerDefinition {
+	return &handlerDefinition{
+		fn:     handlerMethod.Func,
+		name:   fmt.Sprintf("%s.%s", ctrlVal.Type(), handlerMethod.Name),
+		params: fnParamTypes(handlerMethod.Type, 1), // first param for method type is receiver, ignore it
+		fields: injectedFieldTypes(ctrlVal),
+	}
 }
 
 type controllerRoute struct {
+	httpMethod string
 	route      string
 	methodName string
+	middleware []Handler
 }
@@ -6,49 +6,58 @@ import (
 	"github.com/fatih/camelcase"
 	"net/http"
 	"reflect"
+	"sort"
 	"strings"
 	"unsafe"
 )
 
-func resolveProviders(providers []*typedProvider, resolvedValues resolvedValues) []reflect.Value {
-	var provided []reflect.Value
+func fnParamTypes(fnType reflect.Type, firstParamIndex int) []reflect.Type {
+	var paramTypes []reflect.Type
 
-	for _, provider := range providers {
-		if resolvedValue, alreadyResolved := resolvedValues[provider.kind]; alreadyResolved {
-			provided = append(provided, resolvedValue)
-			continue
-		}
+	for i := firstParamIndex; i < fnType.NumIn(); i++ {
+		paramTypes = append(paramTypes, fnType.In(i))
+	}
+
+	return paramTypes
+}
 
-		// When calling provider.value fn resolvedValues variable will be modified with newly resolved values
-		providedVal := reflect.ValueOf(provider.value(resolvedValues))
-		provided = append(provided, providedVal)
+// injectedFields returns Controller fields which are injected from registered value providers
+func injectedFields(ctrlVal reflect.Value) []reflect.StructField {
+	var fields []reflect.StructField
 
-		resolvedValues[provider.kind] = providedVal
+	if ctrlVal.Kind() == reflect.Ptr {
+		ctrlVal = ctrlVal.Elem()
+	} else {
+		ctrlVal = addressableCpy(ctrlVal)
+	}
+
+	for i := 0; i < ctrlVal.NumField(); i++ {
+		if isNilValue(unsafeFieldElem(ctrlVal, i)) {
+			fields = append(fields, ctrlVal.Type().Field(i))
+		}
 	}
 
-	return provided
+	return fields
 }
 
-func resolveController(ctrlType reflect.Type, ctrlFieldProviders []*typedProvider, resolvedValues resolvedValues) *reflect.Value {
-	ctrlPtrVal := reflect.New(ctrlType)
-	ctrlVal := ctrlPtrVal.Elem()
+func injectedFieldTypes(ctrlVal reflect.Value) []reflect.Type {
+	var fieldTypes []reflect.Type
 
-	for i := 0; i < ctrlType.NumField(); i++ {
-		provider := ctrlFieldProviders[i]
-		var providedVal reflect.Value
+	for _, field := range injectedFields(ctrlVal) {
+		fieldTypes = append(fieldTypes, field.Type)
+	}
 
-		if resolvedValue, alreadyResolved := resolvedValues[provider.kind]; alreadyResolved {
-			providedVal = resolvedValue
-		} else {
-			providedVal = reflect.ValueOf(provider.value(resolvedValues))
+	return fieldTypes
+}
 
-			resolvedValues[provider.kind] = providedVal
-		}
+func injectedFieldNames(ctrlVal reflect.Value) []string {
+	var fieldNames []string
 
-		unsafeFieldElem(ctrlVal, i).Set(providedVal)
+	for _, field := range injectedFields(ctrlVal) {
+		fieldNames = append(fieldNames, field.Name)
 	}
 
-	return &ctrlPtrVal
+	return fieldNames
 }
 
 func providerString(p Provider) string {
@@ -66,16 +75,36 @@ func providerString(p Provider) string {
 	)
 }
 
-func handlerHTTPMethod(handlerMethodName string) string {
+// handlerHTTPMethod infers http method from the first camelcase part of Controller method name,
+// returns false along with GET http method when method name does not start with known http method
+func handlerHTTPMethod(handlerMethodName string) (string, bool) {
 	nameParts := camelcase.Split(handlerMethodName)
 
 	for _, httpMethod := range httpMethods {
-		if strings.ToUpper(nameParts[0]) == httpMethod {
-			return httpMethod
+		if len(nameParts) > 0 && strings.ToUpper(nameParts[0]) == httpMethod {
+			return httpMethod, true
+		}
+	}
+
+	return http.MethodGet, false
+}
+
+// parseRouteSpec splits Controller route spec into http method and endpoint,
+// returns empty http method for route without http method
+func parseRouteSpec(routeSpec string) (string, string) {
+	specParts := strings.Fields(routeSpec)
+
+	if len(specParts) != 2 {
+		return "", routeSpec
+	}
+
+	for _, httpMethod := range httpMethods {
+		if specParts[0] == httpMethod {
+			return httpMethod, specParts[1]
 		}
 	}
 
-	return http.MethodGet
+	panic(newInvalidRouteSpecError(routeSpec))
 }
 
 func funcValueOf(fn interface{}) reflect.Value {
@@ -110,10 +139,6 @@ func isNilValue(value reflect.Value) bool {
 	return false
 }
 
-func isPtrType(valType reflect.Type) bool {
-	return valType.Kind() == reflect.Ptr
-}
-
 func isFnType(valType reflect.Type) bool {
 	return valType.Kind() == reflect.Func
 }
@@ -127,6 +152,16 @@ func validateRoutes(routes Routes) error {
 		return newInvalidHandlerError(routes.HandlerFnType().Kind())
 	}
 
+	if seedRoutes, ok := routes.(SeedRoutes); ok {
+		for _, seedType := range seedRoutes.SeedTypes() {
+			if seedType.Implements(contextType) {
+				return nil
+			}
+		}
+
+		return newNoContextSeedTypeError(seedRoutes.SeedTypes())
+	}
+
 	if handlerFnType.NumIn() != 1 {
 		return newInvalidHandlerFnParamCountError()
 	}
@@ -150,20 +185,113 @@ func validateControllerMethod(methodName string, ctrlVal reflect.Value) error {
 	return nil
 }
 
-func routesList(controller Controller) []*controllerRoute {
+// routesList composes Controller routes from Controller Routes method result, panics in strict mode
+// when route has no explicit http method and request handler method name does not start with http method
+func routesList(controller Controller, strict bool) []*controllerRoute {
 	var routesList []*controllerRoute
 
-	for route, routeHandlerMethods := range controller.Routes() {
+	for routeSpec, routeHandlerMethods := range controller.Routes() {
+		specHTTPMethod, route := parseRouteSpec(routeSpec)
+
 		for _, routeHandlerMethod := range routeHandlerMethods {
-			routesList = append(routesList, &controllerRoute{route: route, methodName: routeHandlerMethod})
+			httpMethod, inferred := specHTTPMethod, true
+
+			if httpMethod == "" {
+				httpMethod, inferred = handlerHTTPMethod(routeHandlerMethod)
+			}
+
+			if strict && !inferred {
+				panic(newUninferableHTTPMethodError(reflect.TypeOf(controller), routeHandlerMethod))
+			}
+
+			routesList = append(routesList, &controllerRoute{
+				httpMethod: httpMethod,
+				route:      route,
+				methodName: routeHandlerMethod,
+				middleware: controller.Middleware()[routeHandlerMethod],
+			})
+		}
+	}
+
+	// routes are registered in sorted order, keeping registration same between application starts
+	sort.Slice(routesList, func(i, j int) bool {
+		if routesList[i].route != routesList[j].route {
+			return routesList[i].route < routesList[j].route
+		}
+
+		if routesList[i].httpMethod != routesList[j].httpMethod {
+			return routesList[i].httpMethod < routesList[j].httpMethod
+		}
+
+		return routesList[i].methodName < routesList[j].methodName
+	})
+
+	return routesList
+}
+
+// applyControllerRouteDefaults prefixes Controller routes with Controller base path
+// and prepends Controller middleware to routes middleware
+func applyControllerRouteDefaults(controller Controller, routes []*controllerRoute) {
+	basePath := ""
+	var controllerMiddleware []Handler
+
+	if basePathController, ok := controller.(BasePathController); ok {
+		basePath = basePathController.BasePath()
+	}
+
+	if middlewareController, ok := controller.(MiddlewareController); ok {
+		controllerMiddleware = middlewareController.ControllerMiddleware()
+	}
+
+	for _, route := range routes {
+		route.route = basePath + route.route
+		route.middleware = append(append([]Handler{}, controllerMiddleware...), route.middleware...)
+	}
+}
+
+// methodExprRoutesList composes Controller routes from routes declared with Controller method expressions,
+// panics when method expression does not belong to Controller method set
+func methodExprRoutesList(controller Controller, routes []*ControllerRoute) []*controllerRoute {
+	var routesList []*controllerRoute
+
+	ctrlType := reflect.TypeOf(controller)
+
+	for _, route := range routes {
+		methodName, exists := controllerMethodName(ctrlType, route.method)
+
+		if !exists {
+			panic(newUnknownControllerMethodExprError(ctrlType, route.method))
 		}
+
+		routesList = append(routesList, &controllerRoute{
+			httpMethod: route.httpMethod,
+			route:      route.endPoint,
+			methodName: methodName,
+			middleware: append(append([]Handler{}, controller.Middleware()[methodName]...), route.middleware...),
+		})
 	}
 
 	return routesList
 }
 
-func registeredSingletonProvider(singletonValue interface{}) registeredProvider {
-	return func(resolvedValues) interface{} {
-		return singletonValue
+// controllerMethodName finds Controller method name by method expression code pointer,
+// value receiver method expressions are matched for pointer Controller as well
+func controllerMethodName(ctrlType reflect.Type, methodExpr reflect.Value) (string, bool) {
+	methodTypes := []reflect.Type{ctrlType}
+
+	if ctrlType.Kind() == reflect.Ptr {
+		methodTypes = append(methodTypes, ctrlType.Elem())
 	}
+
+	for _, methodType := range methodTypes {
+		for i := 0; i < methodType.NumMethod(); i++ {
+			method := methodType.Method(i)
+
+			if method.Func.Pointer() == methodExpr.Pointer() {
+				return method.Name, true
+			}
+		}
+	}
+
+	return "", false
 }
@@ -0,0 +1,312 @@
+package injection
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Validator validates values bound from request before request handler is called,
+// values of types embedding Body or Params and types registered with RegisterBindable function are validated.
+// Validator should return ValidationError for invalid values, which is responded with 422 status code.
+// Set with Injector SetValidator method
+type Validator interface {
+	Validate(value interface{}) error
+}
+
+// Validatable can be implemented by values bound from request for validating the value with custom logic,
+// returned error is responded as ValidationError unless it already is one
+type Validatable interface {
+	Validate() error
+}
+
+// FieldError describes validation failure of single value field, Field is empty for failures of whole value
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ValidationError represents validation failure of value bound from request, responded with 422 status code
+// listing all field errors
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// Error returns all field errors joined into single string
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+
+	for _, fieldErr := range e.Errors {
+		if fieldErr.Field == "" {
+			messages = append(messages, fieldErr.Message)
+			continue
+		}
+
+		messages = append(messages, fieldErr.Field+": "+fieldErr.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// ruleValidator is default Validator checking rules of struct field validate tags, followed by Validatable
+// Validate method. Tag rules are separated by comma, supported rules:
+// - required - value is not zero value
+// - min=N, max=N, len=N - numeric value or length of string, slice or map
+// - oneof=a b c - value is one of space separated values
+// Tag rules of struct type are compiled once, on the first use
+type ruleValidator struct{}
+
+var fieldNameTags = []string{"json", "xml", "form", PathParam, QueryParam, HeaderParam, CookieParam}
+
+// compiledTagRules holds validate tag rules compiled by struct type, along with compilation error
+var compiledTagRules sync.Map
+
+type tagRules struct {
+	rules structRules
+	err   error
+}
+
+func (ruleValidator) Validate(value interface{}) error {
+	structVal := reflect.ValueOf(value)
+
+	if structVal.Kind() == reflect.Ptr {
+		structVal = structVal.Elem()
+	}
+
+	if structVal.Kind() == reflect.Struct {
+		rules, err := compileTagRules(structVal.Type())
+
+		if err != nil {
+			return err
+		}
+
+		if err := rules.check(structVal); err != nil {
+			return err
+		}
+	}
+
+	validatable, ok := value.(Validatable)
+
+	if !ok {
+		return nil
+	}
+
+	err := validatable.Validate()
+
+	if _, isValidationErr := err.(*ValidationError); err == nil || isValidationErr {
+		return err
+	}
+
+	return &ValidationError{Errors: []FieldError{{Message: err.Error()}}}
+}
+
+// compileTagRules returns compiled validate tag rules of given struct type fields,
+// returns Error when tags contain invalid rule
+func compileTagRules(structType reflect.Type) (structRules, error) {
+	if compiled, exists := compiledTagRules.Load(structType); exists {
+		return compiled.(*tagRules).rules, compiled.(*tagRules).err
+	}
+
+	fieldRules := make(map[string]string)
+
+	for i := 0; i < structType.NumField(); i++ {
+		if rules, exists := structType.Field(i).Tag.Lookup("validate"); exists {
+			fieldRules[structType.Field(i).Name] = rules
+		}
+	}
+
+	rules, err := compileRules(structType, fieldRules)
+	compiledTagRules.Store(structType, &tagRules{rules: rules, err: err})
+
+	return rules, err
+}
+
+// structRules is validation rules of struct type fields
+type structRules []*fieldValidation
+
+// fieldValidation is validation rules of single struct field, name is field name used in field errors
+type fieldValidation struct {
+	index int
+	name  string
+	rules []validationRule
+}
+
+// validationRule is single validation rule, limit is numeric argument of min, max and len rules
+type validationRule struct {
+	name     string
+	argument string
+	limit    float64
+}
+
+// compileRules compiles rules of given struct type fields mapped by field name, either Go field name or
+// name given with field tags. Returns Error when rules contain unknown field, unknown rule,
+// rule with invalid argument or rule not supported for field type
+func compileRules(structType reflect.Type, fieldRules map[string]string) (structRules, error) {
+	var compiled structRules
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		rules, exists := fieldRules[field.Name]
+
+		if !exists {
+			rules, exists = fieldRules[fieldName(field)]
+		}
+
+		if !exists {
+			continue
+		}
+
+		compiledField := &fieldValidation{index: i, name: fieldName(field)}
+
+		for _, rule := range strings.Split(rules, ",") {
+			compiledRule, err := compileRule(field.Type, rule)
+
+			if err != nil {
+				return nil, newInvalidValidationRuleError(structType, field.Name, rule)
+			}
+
+			compiledField.rules = append(compiledField.rules, compiledRule)
+		}
+
+		compiled = append(compiled, compiledField)
+	}
+
+	if len(compiled) != len(fieldRules) {
+		return nil, newUnknownRuleFieldError(structType, fieldRules)
+	}
+
+	return compiled, nil
+}
+
+// compileRule compiles rule for field of given type, returns error for unknown rule,
+// rule with invalid argument or rule not supported for the type
+func compileRule(fieldType reflect.Type, rule string) (validationRule, error) {
+	ruleName, argument, _ := strings.Cut(strings.TrimSpace(rule), "=")
+	compiled := validationRule{name: ruleName, argument: argument}
+
+	switch ruleName {
+	case "required", "oneof":
+		return compiled, nil
+	case "min", "max", "len":
+		limit, err := strconv.ParseFloat(argument, 64)
+
+		if err != nil {
+			return compiled, err
+		}
+
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if _, _, ok := valueSize(reflect.Zero(fieldType)); !ok {
+			return compiled, fmt.Errorf("rule %s is not supported for %s", ruleName, fieldType)
+		}
+
+		compiled.limit = limit
+
+		return compiled, nil
+	}
+
+	return compiled, fmt.Errorf("unknown rule %s", ruleName)
+}
+
+// check checks given struct value fields against the rules, returns ValidationError listing fields failing the rules
+func (s structRules) check(structVal reflect.Value) error {
+	var fieldErrors []FieldError
+
+	for _, field := range s {
+		for _, rule := range field.rules {
+			if message := rule.check(structVal.Field(field.index)); message != "" {
+				fieldErrors = append(fieldErrors, FieldError{Field: field.name, Message: message})
+				break
+			}
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return &ValidationError{Errors: fieldErrors}
+	}
+
+	return nil
+}
+
+// fieldName returns field name used in field errors, name given with field tags is preferred over Go field name
+func fieldName(field reflect.StructField) string {
+	for _, tagName := range fieldNameTags {
+		if name := strings.Split(field.Tag.Get(tagName), ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// check returns failure message when given value does not satisfy the rule
+func (r validationRule) check(value reflect.Value) string {
+	// rules other than required are not checked for missing optional values
+	if r.name != "required" && value.Kind() == reflect.Ptr && value.IsNil() {
+		return ""
+	}
+
+	switch r.name {
+	case "required":
+		if value.IsZero() {
+			return "is required"
+		}
+	case "oneof":
+		for _, allowed := range strings.Fields(r.argument) {
+			if fmt.Sprint(reflect.Indirect(value)) == allowed {
+				return ""
+			}
+		}
+
+		return fmt.Sprintf("must be one of %s", r.argument)
+	default:
+		size, measure, _ := valueSize(reflect.Indirect(value))
+
+		switch {
+		case r.name == "min" && size < r.limit:
+			return fmt.Sprintf("must have %s of at least %s", measure, r.argument)
+		case r.name == "max" && size > r.limit:
+			return fmt.Sprintf("must have %s of at most %s", measure, r.argument)
+		case r.name == "len" && size != r.limit:
+			return fmt.Sprintf("must have %s of %s", measure, r.argument)
+		}
+	}
+
+	return ""
+}
+
+// valueSize returns numeric value or length of given value along with measure name
+func valueSize(value reflect.Value) (float64, string, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		return float64(len([]rune(value.String()))), "length", true
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return float64(value.Len()), "length", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), "value", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), "value", true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), "value", true
+	}
+
+	return 0, "", false
+}
+
+// SetValidator sets Validator used for validating values bound from request for the Injector and all its groups,
+// by default values are validated by validate tag rules and Validatable implementation
+func (r *Injector) SetValidator(validator Validator) {
+	r.root().validator = validator
+}
+
+// validate validates value bound from request with Injector Validator,
+// aborts request handling when value is invalid
+func (r *Injector) validate(value reflect.Value) {
+	if err := r.root().validator.Validate(value.Interface()); err != nil {
+		panic(requestFailure{err})
+	}
+}
@@ -0,0 +1,60 @@
+package injection
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func TestRuleValidator_Validate(t *testing.T) {
+	type request struct {
+		Name  string   `json:"name" validate:"required"`
+		Code  string   `query:"code" validate:"len=2"`
+		Tags  []string `validate:"max=1"`
+		Limit *int     `validate:"min=1,max=10"`
+	}
+
+	testCases := map[string]func(t *testing.T){
+		"successfully validate valid value": func(t *testing.T) {
+			err := ruleValidator{}.Validate(&request{Name: "name", Code: "ee", Tags: []string{"a"}})
+
+			assert.Nil(t, err)
+		},
+		"return ValidationError listing failed field rules": func(t *testing.T) {
+			limit := 11
+			err := ruleValidator{}.Validate(&request{Code: "est", Tags: []string{"a", "b"}, Limit: &limit})
+
+			assert.Equal(t, &ValidationError{Errors: []FieldError{
+				{Field: "name", Message: "is required"},
+				{Field: "code", Message: "must have length of 2"},
+				{Field: "Tags", Message: "must have length of at most 1"},
+				{Field: "Limit", Message: "must have value of at most 10"},
+			}}, err)
+			assert.Equal(t, "name: is required; code: must have length of 2; "+
+				"Tags: must have length of at most 1; Limit: must have value of at most 10", err.Error())
+		},
+		"fail compiling rules of unknown field and rule with invalid argument": func(t *testing.T) {
+			structType := reflect.TypeOf(request{})
+
+			_, unknownFieldErr := compileRules(structType, map[string]string{"Missing": "required"})
+			_, invalidArgumentErr := compileRules(structType, map[string]string{"Tags": "max=many"})
+			rules, err := compileRules(structType, map[string]string{"code": "len=2"})
+
+			assert.IsType(t, Error{}, unknownFieldErr)
+			assert.IsType(t, Error{}, invalidArgumentErr)
+			assert.Nil(t, err)
+			assert.Nil(t, rules.check(reflect.ValueOf(request{Code: "ee"})))
+		},
+		"fail validating value with unknown rule": func(t *testing.T) {
+			err := ruleValidator{}.Validate(struct {
+				Name string `validate:"email"`
+			}{})
+
+			assert.IsType(t, Error{}, err)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}
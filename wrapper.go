@@ -0,0 +1,173 @@
+package injection
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Wrapper is reflection free request handler generated by injection-gen command for request handler function or
+// Controller request handler method. Injector uses registered Wrapper in place of reflection based request handler
+// when all value providers called by the Wrapper are registered with the Injector under same lifetime
+// and route has no middleware, otherwise reflection based request handler is used.
+// Request scope of Routes implementing ScopeRoutes interface is set up before Wrapper request handler runs
+type Wrapper struct {
+	// Handler is wrapped request handler function or Controller method expression, example: (*UserController).GetUsers
+	Handler interface{}
+
+	// Providers lists value provider functions called by Fn, singleton providers are wrapped with NewSingletonProvider
+	Providers []Provider
+
+	// Fields lists Controller field names injected by Fn, only used with Controller method wrappers
+	Fields []string
+
+	// Fn is function returning request handler of Routes HandlerFnType signature, it receives functions returning
+	// values of singleton providers listed in Providers, in their order. Singleton values are resolved and held
+	// by the Injector, functions returning them have Routes HandlerFnType input parameters.
+	// Fn of Controller method wrapper receives registered Controller value before singleton value functions
+	Fn interface{}
+}
+
+var wrappers = struct {
+	sync.RWMutex
+	byHandler map[uintptr]*Wrapper
+}{byHandler: map[uintptr]*Wrapper{}}
+
+// RegisterWrapper registers generated request handler Wrapper, should be called from init function of generated code
+func RegisterWrapper(wrapper Wrapper) {
+	wrappers.Lock()
+	defer wrappers.Unlock()
+
+	wrappers.byHandler[funcValueOf(wrapper.Handler).Pointer()] = &wrapper
+}
+
+func registeredWrapper(handlerFn reflect.Value) *Wrapper {
+	wrappers.RLock()
+	defer wrappers.RUnlock()
+
+	return wrappers.byHandler[handlerFn.Pointer()]
+}
+
+// wrappedHandler returns registered Wrapper request handler for given handler function of given route,
+// returns false when no Wrapper is registered or Wrapper does not match Injector value providers
+func (r *Injector) wrappedHandler(handlerFn reflect.Value, route string) (reflect.Value, bool) {
+	wrapper := registeredWrapper(handlerFn)
+
+	if wrapper == nil || !r.providesWrapperValues(wrapper) {
+		return reflect.Value{}, false
+	}
+
+	return r.wrapperHandlerFn(wrapper, nil, route)
+}
+
+// wrappedControllerHandler returns registered Wrapper request handler for given Controller method of given route
+func (r *Injector) wrappedControllerHandler(
+	ctrlVal reflect.Value,
+	handlerMethod reflect.Method,
+	route string,
+) (reflect.Value, bool) {
+	wrapper := registeredWrapper(handlerMethod.Func)
+
+	if wrapper == nil || !r.providesWrapperValues(wrapper) || !injectsWrapperFields(ctrlVal, wrapper) {
+		return reflect.Value{}, false
+	}
+
+	return r.wrapperHandlerFn(wrapper, []reflect.Value{ctrlVal}, route)
+}
+
+// wrapperHandlerFn calls Wrapper Fn with given leading values followed by functions returning values
+// of Wrapper singleton providers, returns false when Fn signature does not match
+func (r *Injector) wrapperHandlerFn(wrapper *Wrapper, args []reflect.Value, route string) (reflect.Value, bool) {
+	args = append(args, r.singletonValueFns(wrapper, route)...)
+	fn := reflect.ValueOf(wrapper.Fn)
+	fnType := fn.Type()
+
+	if fnType.Kind() != reflect.Func || fnType.NumIn() != len(args) || fnType.NumOut() != 1 ||
+		!fnType.Out(0).ConvertibleTo(r.routes.HandlerFnType()) {
+		return reflect.Value{}, false
+	}
+
+	for i, arg := range args {
+		if fnType.In(i) != arg.Type() {
+			return reflect.Value{}, false
+		}
+	}
+
+	handler := fn.Call(args)[0]
+	plan := r.compilePlan(nil, route)
+
+	// failures of generated handler are handled same as failures of reflection based request handlers
+	return reflect.MakeFunc(r.routes.HandlerFnType(), func(args []reflect.Value) (results []reflect.Value) {
+		seeds := r.seedValues(args)
+		defer r.recoverRequestFailure(seeds, route)
+
+		// request scope is stored on request context before generated handler runs,
+		// so that code it calls reaches the scope with ScopeFromContext and FromContext functions
+		if r.scopes != nil {
+			plan.scope(seeds)
+		}
+
+		return handler.Call(args)
+	}), true
+}
+
+// singletonValueFns returns functions resolving values of Wrapper singleton providers from request handler
+// input values, values are resolved with singleton providers registered with the Injector
+func (r *Injector) singletonValueFns(wrapper *Wrapper, route string) []reflect.Value {
+	var valueFns []reflect.Value
+	handlerType := r.routes.HandlerFnType()
+
+	for _, provider := range wrapper.Providers {
+		singletonProvider, ok := provider.(*singletonProvider)
+
+		if !ok {
+			continue
+		}
+
+		valueType := funcValueOf(singletonProvider.provider).Type().Out(0)
+		plan := r.compilePlan([]reflect.Type{valueType}, route)
+		valueFnType := reflect.FuncOf(fnParamTypes(handlerType, 0), []reflect.Type{valueType}, false)
+
+		valueFns = append(valueFns, reflect.MakeFunc(valueFnType, func(args []reflect.Value) []reflect.Value {
+			return plan.arguments(plan.scope(r.seedValues(args)), 0)
+		}))
+	}
+
+	return valueFns
+}
+
+func (r *Injector) providesWrapperValues(wrapper *Wrapper) bool {
+	for _, provider := range wrapper.Providers {
+		lifetime := requestLifetime
+
+		if singletonProvider, ok := provider.(*singletonProvider); ok {
+			provider = singletonProvider.provider
+			lifetime = singletonLifetime
+		}
+
+		providerFn := funcValueOf(provider)
+		definition, exists := r.provider(providerFn.Type().Out(0))
+
+		if !exists || !definition.fn.IsValid() || definition.lifetime != lifetime ||
+			definition.fn.Pointer() != providerFn.Pointer() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func injectsWrapperFields(ctrlVal reflect.Value, wrapper *Wrapper) bool {
+	injectedFields := injectedFieldNames(ctrlVal)
+
+	if len(injectedFields) != len(wrapper.Fields) {
+		return false
+	}
+
+	for i, fieldName := range injectedFields {
+		if wrapper.Fields[i] != fieldName {
+			return false
+		}
+	}
+
+	return true
+}
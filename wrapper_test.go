@@ -0,0 +1,239 @@
+package injection
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/surmus/injection/test"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+var wrapperExecuted bool
+
+var wrappedHandlerExecuted bool
+
+type wrappedSingleton struct{}
+
+var wrappedSingletonCalls int
+
+var wrappedSingletonValue *wrappedSingleton
+
+func provideWrappedSingleton() *wrappedSingleton {
+	wrappedSingletonCalls++
+
+	return &wrappedSingleton{}
+}
+
+func wrappedSingletonHandler(ctx context.Context, singleton *wrappedSingleton) {
+	wrappedSingletonValue = singleton
+}
+
+func provideWrappedDependency(ctx context.Context) *test.DependencyStruct {
+	return &test.DependencyStruct{Ctx: ctx}
+}
+
+func wrappedHandler(ctx context.Context, dependency *test.DependencyStruct) {
+	wrappedHandlerExecuted = true
+}
+
+func panickingWrappedHandler(ctx context.Context, dependency *test.DependencyStruct) {
+	panic("wrapped handler failure")
+}
+
+type responseRoutes struct {
+	testRoutes
+	recorder *httptest.ResponseRecorder
+}
+
+func (r *responseRoutes) ResponseWriter(seeds []reflect.Value) http.ResponseWriter {
+	return r.recorder
+}
+
+type WrappedController struct {
+	BaseController
+
+	Dependency *test.DependencyStruct
+}
+
+func (c *WrappedController) Routes() map[string][]string {
+	return map[string][]string{test.Endpoint: {"GetTest"}}
+}
+
+func (c *WrappedController) GetTest(ctx context.Context) {
+	wrappedHandlerExecuted = true
+}
+
+func init() {
+	RegisterWrapper(Wrapper{
+		Handler:   wrappedHandler,
+		Providers: []Provider{provideWrappedDependency},
+		Fn: func() func(context.Context) {
+			return func(ctx context.Context) {
+				wrapperExecuted = true
+				wrappedHandler(ctx, provideWrappedDependency(ctx))
+			}
+		},
+	})
+	RegisterWrapper(Wrapper{
+		Handler:   wrappedSingletonHandler,
+		Providers: []Provider{NewSingletonProvider(provideWrappedSingleton)},
+		Fn: func(singleton0 func(context.Context) *wrappedSingleton) func(context.Context) {
+			return func(ctx context.Context) {
+				wrapperExecuted = true
+				wrappedSingletonHandler(ctx, singleton0(ctx))
+			}
+		},
+	})
+	RegisterWrapper(Wrapper{
+		Handler:   panickingWrappedHandler,
+		Providers: []Provider{provideWrappedDependency},
+		Fn: func() func(context.Context) {
+			return func(ctx context.Context) {
+				wrapperExecuted = true
+				panickingWrappedHandler(ctx, provideWrappedDependency(ctx))
+			}
+		},
+	})
+	RegisterWrapper(Wrapper{
+		Handler:   (*WrappedController).GetTest,
+		Providers: []Provider{provideWrappedDependency},
+		Fields:    []string{"Dependency"},
+		Fn: func(ctrl *WrappedController) func(context.Context) {
+			return func(ctx context.Context) {
+				wrapperExecuted = true
+				resolvedCtrl := *ctrl
+				resolvedCtrl.Dependency = provideWrappedDependency(ctx)
+				resolvedCtrl.GetTest(ctx)
+			}
+		},
+	})
+}
+
+func TestRegisterWrapper(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"should use wrapper for handler function": func(t *testing.T) {
+			wrapperExecuted, wrappedHandlerExecuted = false, false
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(provideWrappedDependency)
+
+			err := injector.Handle(http.MethodGet, test.Endpoint, wrappedHandler)
+
+			assert.Nil(t, err)
+			assert.True(t, wrapperExecuted)
+			assert.True(t, wrappedHandlerExecuted)
+		},
+		"should use wrapper for controller method": func(t *testing.T) {
+			wrapperExecuted, wrappedHandlerExecuted = false, false
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(provideWrappedDependency)
+
+			err := injector.RegisterController(new(WrappedController))
+
+			assert.Nil(t, err)
+			assert.True(t, wrapperExecuted)
+			assert.True(t, wrappedHandlerExecuted)
+		},
+		"should resolve wrapper singleton values with Injector singleton providers": func(t *testing.T) {
+			wrapperExecuted, wrappedSingletonCalls, wrappedSingletonValue = false, 0, nil
+			routes := &benchmarkRoutes{testRoutes: testRoutes{t: t}}
+			injector, _ := NewInjector(routes)
+			injector.RegisterProviders(NewSingletonProvider(provideWrappedSingleton))
+			var handlerSingleton *wrappedSingleton
+
+			assert.Nil(t, injector.Handle(http.MethodGet, "/reflected", func(singleton *wrappedSingleton) {
+				handlerSingleton = singleton
+			}))
+
+			err := injector.Handle(http.MethodGet, test.Endpoint, wrappedSingletonHandler)
+			routes.callHandlers()
+			routes.callHandlers()
+
+			assert.Nil(t, err)
+			assert.True(t, wrapperExecuted)
+			assert.Equal(t, 1, wrappedSingletonCalls)
+			assert.NotNil(t, wrappedSingletonValue)
+			assert.Same(t, handlerSingleton, wrappedSingletonValue)
+		},
+		"should not use wrapper for route with middleware": func(t *testing.T) {
+			wrapperExecuted, wrappedHandlerExecuted = false, false
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(provideWrappedDependency)
+
+			assert.Nil(t, injector.Use(func(dependency *test.DependencyStruct) {}))
+
+			err := injector.Handle(http.MethodGet, test.Endpoint, wrappedHandler)
+
+			assert.Nil(t, err)
+			assert.False(t, wrapperExecuted)
+			assert.True(t, wrappedHandlerExecuted)
+		},
+		"should not use wrapper for route with route middleware": func(t *testing.T) {
+			wrapperExecuted, wrappedHandlerExecuted = false, false
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(provideWrappedDependency)
+
+			err := injector.Handle(http.MethodGet, test.Endpoint, func(dependency *test.DependencyStruct) {}, wrappedHandler)
+
+			assert.Nil(t, err)
+			assert.False(t, wrapperExecuted)
+			assert.True(t, wrappedHandlerExecuted)
+		},
+		"should respond with problem details when wrapped handler panics": func(t *testing.T) {
+			wrapperExecuted = false
+			routes := &responseRoutes{testRoutes: testRoutes{t: t}, recorder: httptest.NewRecorder()}
+			injector, _ := NewInjector(routes)
+			injector.RegisterProviders(provideWrappedDependency)
+
+			err := injector.Handle(http.MethodGet, test.Endpoint, panickingWrappedHandler)
+
+			assert.Nil(t, err)
+			assert.True(t, wrapperExecuted)
+			assert.Equal(t, http.StatusInternalServerError, routes.recorder.Code)
+			assert.Equal(t, "application/problem+json", routes.recorder.Header().Get("Content-Type"))
+			assert.JSONEq(
+				t,
+				`{"type":"about:blank","title":"Internal Server Error","status":500}`,
+				routes.recorder.Body.String(),
+			)
+		},
+		"should not use wrapper when provider differs": func(t *testing.T) {
+			wrapperExecuted, wrappedHandlerExecuted = false, false
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(func() *test.DependencyStruct { return &test.DependencyStruct{} })
+
+			err := injector.Handle(http.MethodGet, test.Endpoint, wrappedHandler)
+
+			assert.Nil(t, err)
+			assert.False(t, wrapperExecuted)
+			assert.True(t, wrappedHandlerExecuted)
+		},
+		"should not use wrapper when provider lifetime differs": func(t *testing.T) {
+			wrapperExecuted, wrappedHandlerExecuted = false, false
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(NewSingletonProvider(provideWrappedDependency))
+
+			err := injector.Handle(http.MethodGet, test.Endpoint, wrappedHandler)
+
+			assert.Nil(t, err)
+			assert.False(t, wrapperExecuted)
+			assert.True(t, wrappedHandlerExecuted)
+		},
+		"should not use wrapper when controller field is static": func(t *testing.T) {
+			wrapperExecuted, wrappedHandlerExecuted = false, false
+			injector, _ := NewInjector(&testRoutes{t: t})
+			injector.RegisterProviders(provideWrappedDependency)
+
+			err := injector.RegisterController(&WrappedController{Dependency: &test.DependencyStruct{}})
+
+			assert.Nil(t, err)
+			assert.False(t, wrapperExecuted)
+			assert.True(t, wrappedHandlerExecuted)
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, testCase)
+	}
+}